@@ -0,0 +1,8 @@
+data "awx_group" "example-id" {
+  id = "1"
+}
+
+data "awx_group" "example-name" {
+  name      = "webservers"
+  inventory = 1
+}
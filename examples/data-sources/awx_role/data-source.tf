@@ -0,0 +1,5 @@
+data "awx_role" "example" {
+  resource_type = "job_template"
+  resource_id   = 12
+  role          = "execute_role"
+}
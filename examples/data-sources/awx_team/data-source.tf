@@ -0,0 +1,8 @@
+data "awx_team" "example-id" {
+  id = "1"
+}
+
+data "awx_team" "example-name" {
+  name         = "operators"
+  organization = 1
+}
@@ -16,3 +16,9 @@ provider "awx" {
   username = "admin"
   password = "password"
 }
+
+provider "awx" {
+  endpoint     = "https://tower.internal.example.com"
+  token        = "awxtoken"
+  ca_cert_file = "/etc/pki/tls/certs/internal-ca.pem"
+}
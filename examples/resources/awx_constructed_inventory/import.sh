@@ -0,0 +1 @@
+terraform import awx_constructed_inventory.example 1
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "awx_constructed_inventory" "example" {
+  name         = "shutdown-hosts"
+  description  = "Hosts of every cloud inventory that are shut down"
+  organization = 1
+
+  # when inventories have the same host, the variables of the later inventory win
+  input_inventories = [awx_inventory.aws.id, awx_inventory.azure.id]
+
+  limit       = "shutdown"
+  source_vars = <<-EOT
+    plugin: constructed
+    strict: true
+    groups:
+      shutdown: resolved_state == "shutdown"
+  EOT
+}
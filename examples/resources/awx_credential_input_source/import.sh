@@ -0,0 +1 @@
+terraform import awx_credential_input_source.machine-password 1
\ No newline at end of file
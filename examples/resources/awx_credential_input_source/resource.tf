@@ -0,0 +1,41 @@
+data "awx_credential_type" "vault" {
+  name = "HashiCorp Vault Secret Lookup"
+  kind = "external"
+}
+
+data "awx_credential_type" "machine" {
+  name = "Machine"
+  kind = "ssh"
+}
+
+resource "awx_credential" "vault" {
+  name            = "vault"
+  organization    = 1
+  credential_type = data.awx_credential_type.vault.id
+  inputs = jsonencode({
+    "url" : "https://vault.example.com:8200",
+    "token" : var.vault_token,
+    "api_version" : "v2"
+  })
+}
+
+// the password is left out of the inputs, it is looked up in vault
+resource "awx_credential" "machine" {
+  name            = "deploy"
+  organization    = 1
+  credential_type = data.awx_credential_type.machine.id
+  inputs = jsonencode({
+    "username" : "deploy"
+  })
+}
+
+resource "awx_credential_input_source" "machine-password" {
+  target_credential = awx_credential.machine.id
+  input_field_name  = "password"
+  source_credential = awx_credential.vault.id
+  metadata = jsonencode({
+    "secret_backend" : "secret",
+    "secret_path" : "/deploy",
+    "secret_key" : "password"
+  })
+}
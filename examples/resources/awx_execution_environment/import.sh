@@ -0,0 +1 @@
+terraform import awx_execution_environment.example 1
\ No newline at end of file
@@ -0,0 +1,7 @@
+terraform {
+  required_providers {
+    awx = {
+      source = "TravisStratton/awx"
+    }
+  }
+}
@@ -0,0 +1,8 @@
+resource "awx_execution_environment" "example" {
+  name         = "network-ee"
+  description  = "Network automation collections"
+  image        = "registry.example.com/ansible/network-ee:1.4"
+  pull         = "missing"
+  organization = 1
+  credential   = 5
+}
@@ -0,0 +1,9 @@
+resource "awx_group" "example" {
+  name        = "webservers"
+  description = "Front end web servers"
+  inventory   = 1
+  variables   = <<-EOT
+    http_port: 80
+    ntp_server: ntp.example.com
+  EOT
+}
@@ -0,0 +1 @@
+terraform import awx_group_children.example 1
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "awx_group" "datacenter" {
+  name      = "datacenter"
+  inventory = 1
+}
+
+resource "awx_group_children" "example" {
+  group_id  = awx_group.datacenter.id
+  child_ids = [awx_group.webservers.id, awx_group.dbservers.id]
+}
@@ -0,0 +1 @@
+terraform import awx_group_hosts.example 1
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "awx_group_hosts" "example" {
+  group_id = awx_group.example.id
+  host_ids = [3, 7]
+}
+
+# only add these hosts, leaving any other host of the group alone
+resource "awx_group_hosts" "example-additive" {
+  group_id      = awx_group.example.id
+  host_ids      = [9]
+  authoritative = false
+}
@@ -0,0 +1,28 @@
+resource "awx_instance_group" "example" {
+  name                       = "datacenter-east"
+  policy_instance_percentage = 50
+  policy_instance_list       = ["awx-east-1.example.com"]
+  max_concurrent_jobs        = 20
+}
+
+resource "awx_instance_group" "openshift" {
+  name               = "openshift"
+  is_container_group = true
+  credential         = 8
+  max_forks          = 200
+  pod_spec_override  = <<-EOT
+    apiVersion: v1
+    kind: Pod
+    metadata:
+      namespace: awx-jobs
+    spec:
+      serviceAccountName: default
+      containers:
+        - image: quay.io/ansible/awx-ee:latest
+          name: worker
+          args:
+            - ansible-runner
+            - worker
+            - --private-data-dir=/runner
+  EOT
+}
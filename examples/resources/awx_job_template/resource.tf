@@ -16,3 +16,15 @@ resource "awx_job_template" "example" {
   project   = awx_organization.example.id
   playbook  = "test.yml"
 }
+
+# A job template launched by GitHub pushes. Change rotate_webhook_key to regenerate
+# the key, and pass webhook_url and webhook_key to e.g. a github_repository_webhook.
+resource "awx_job_template" "webhook" {
+  job_type           = "run"
+  name               = "deploy on push"
+  inventory          = awx_inventory.example.id
+  project            = awx_organization.example.id
+  playbook           = "deploy.yml"
+  webhook_service    = "github"
+  rotate_webhook_key = "2026-10"
+}
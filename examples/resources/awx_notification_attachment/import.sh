@@ -0,0 +1 @@
+terraform import awx_notification_attachment.job-template-error job_template/12/error
\ No newline at end of file
@@ -0,0 +1,15 @@
+resource "awx_notification_attachment" "job-template-error" {
+  parent_type               = "job_template"
+  parent_id                 = awx_job_template.example.id
+  event                     = "error"
+  notification_template_ids = [awx_notification_template.example.id]
+}
+
+# only attach this notification template, leaving any other of the event alone
+resource "awx_notification_attachment" "workflow-approvals" {
+  parent_type               = "workflow_job_template"
+  parent_id                 = awx_workflow_job_template.example.id
+  event                     = "approvals"
+  notification_template_ids = [awx_notification_template.example.id]
+  authoritative             = false
+}
@@ -2,41 +2,45 @@ resource "awx_notification_template" "example" {
   name              = "example1"
   notification_type = "slack"
   organization      = 1
-  notification_configuration = jsonencode({
-    channels  = ["#channel1", "#channel1"]
-    hex_color = ""
-    token     = ""
-  })
-  messages = jsonencode({
-    error = {
-      body    = ""
-      message = ""
-    }
+  slack = {
+    channels = ["#channel1", "#channel2"]
+    token    = var.slack_token
+  }
+  messages = {
     started = {
-      body    = ""
       message = "{{ job_friendly_name }} #{{ job.id }} '{{ job.name }}' {{ job.status }}: {{ url }} Custom Message"
     }
-    success = {
-      body    = ""
-      message = ""
-    }
     workflow_approval = {
-      approved = {
-        body    = ""
-        message = ""
-      }
-      denied = {
-        body    = ""
-        message = ""
-      }
       running = {
-        body    = ""
-        message = ""
-      }
-      timed_out = {
-        body    = ""
-        message = ""
+        message = "The approval node \"{{ approval_node_name }}\" needs review: {{ workflow_url }}"
       }
     }
-  })
+  }
+}
+
+resource "awx_notification_template" "example-email" {
+  name              = "example-email"
+  notification_type = "email"
+  organization      = 1
+  email = {
+    host       = "smtp.example.com"
+    port       = 587
+    use_tls    = true
+    username   = "awx"
+    password   = var.smtp_password
+    sender     = "awx@example.com"
+    recipients = ["ops@example.com"]
+  }
+}
+
+resource "awx_notification_template" "example-webhook" {
+  name              = "example-webhook"
+  notification_type = "webhook"
+  organization      = 1
+  webhook = {
+    url = "https://hooks.example.com/awx"
+    headers = {
+      "X-Api-Key" = var.webhook_api_key
+    }
+  }
 }
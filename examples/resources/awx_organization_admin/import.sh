@@ -0,0 +1 @@
+terraform import awx_organization_admin.example 1
\ No newline at end of file
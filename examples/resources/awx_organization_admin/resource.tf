@@ -0,0 +1,5 @@
+resource "awx_organization_admin" "example" {
+  organization_id = 1
+  user_ids        = [3]
+  authoritative   = false
+}
@@ -0,0 +1,4 @@
+resource "awx_organization_member" "example" {
+  organization_id = 1
+  user_ids        = [3, 7]
+}
@@ -0,0 +1 @@
+terraform import awx_role_assignment.operators-execute job_template/12/execute_role/team/3
\ No newline at end of file
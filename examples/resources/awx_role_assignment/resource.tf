@@ -0,0 +1,13 @@
+resource "awx_role_assignment" "operators-execute" {
+  resource_type = "job_template"
+  resource_id   = 12
+  role          = "execute_role"
+  team_id       = awx_team.operators.id
+}
+
+resource "awx_role_assignment" "alice-inventory-admin" {
+  resource_type = "inventory"
+  resource_id   = 4
+  role          = "admin_role"
+  user_id       = 7
+}
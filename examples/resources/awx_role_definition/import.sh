@@ -0,0 +1 @@
+terraform import awx_role_definition.example 1
\ No newline at end of file
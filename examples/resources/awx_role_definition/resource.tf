@@ -0,0 +1,6 @@
+resource "awx_role_definition" "example" {
+  name         = "Inventory Operator"
+  description  = "May view, sync and run ad hoc commands against an inventory"
+  permissions  = ["awx.view_inventory", "awx.update_inventory", "awx.adhoc_inventory"]
+  content_type = "awx.inventory"
+}
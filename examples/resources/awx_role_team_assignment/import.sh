@@ -0,0 +1 @@
+terraform import awx_role_team_assignment.example 1
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "awx_role_team_assignment" "example" {
+  role_definition = awx_role_definition.example.id
+  team            = awx_team.operators.id
+  object_id       = "4"
+}
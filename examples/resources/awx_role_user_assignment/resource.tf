@@ -0,0 +1,5 @@
+resource "awx_role_user_assignment" "example" {
+  role_definition = awx_role_definition.example.id
+  user            = 7
+  object_id       = "4"
+}
@@ -0,0 +1,5 @@
+resource "awx_team" "example" {
+  name         = "operators"
+  description  = "Runs the day to day job templates"
+  organization = 1
+}
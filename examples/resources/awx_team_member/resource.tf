@@ -0,0 +1,11 @@
+resource "awx_team_member" "example" {
+  team_id  = awx_team.example.id
+  user_ids = [3, 7]
+}
+
+# only add these users, leaving any other member of the team alone
+resource "awx_team_member" "example-additive" {
+  team_id       = awx_team.example.id
+  user_ids      = [9]
+  authoritative = false
+}
@@ -0,0 +1,750 @@
+// Package awxmock is an in-memory fake of the parts of the AWX API the provider uses,
+// so the provider can be tested without a running controller.
+//
+// Objects are stored as the JSON documents the provider sends, keyed by their collection
+// (e.g. "job_templates") and an id the server assigns. The server implements
+//
+//   - list, create, read, update (PUT and PATCH merge into the stored object) and delete,
+//   - AWX style pagination with page and page_size, and filtering on exact field values,
+//   - related collections (e.g. job_templates/N/labels/) with associate and disassociate,
+//   - job template survey specs, workflow nodes and approval templates,
+//   - webhook keys and receivers of job and workflow job templates with a webhook_service,
+//   - the roles AWX creates with an object, listed in its summary_fields.object_roles,
+//   - notification secrets returned as "$encrypted$",
+//   - constructed_inventories/ as a view of the inventories of kind constructed,
+//   - /ping/ reporting Version, and an API root document advertising Endpoints,
+//   - 404 for any object that does not exist.
+package awxmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// BasePath is the API path the server serves, advertised by its /api/ root document.
+	BasePath = "/api/v2/"
+
+	// DefaultVersion is the AWX version a new server reports from /ping/.
+	DefaultVersion = "24.6.1"
+
+	defaultPageSize = 25
+	maxPageSize     = 200
+)
+
+// DefaultEndpoints are the endpoints a new server advertises in its API root document.
+var DefaultEndpoints = []string{
+	"config", "constructed_inventories", "credential_input_sources", "credential_types", "credentials", "execution_environments",
+	"groups", "hosts", "instance_groups", "inventories", "inventory_sources", "job_templates", "labels",
+	"me", "notification_templates", "organizations", "ping", "projects", "role_definitions",
+	"role_team_assignments", "role_user_assignments", "roles", "schedules", "teams", "users",
+	"workflow_job_template_nodes", "workflow_job_templates",
+}
+
+// Object is a single AWX object as stored and returned by the server.
+type Object map[string]any
+
+// Server is a fake AWX API. Create one with NewServer and Close it when done.
+type Server struct {
+	*httptest.Server
+
+	// Version is the AWX version reported by /ping/, set it before the provider is configured.
+	Version string
+	// Endpoints are the top level endpoints the API root document advertises, e.g. leave out
+	// role_definitions to look like a controller without the new RBAC API.
+	Endpoints []string
+	// RootUnreadable makes the API root document answer 404, so the endpoints the controller
+	// has cannot be told.
+	RootUnreadable bool
+	// WebhookKeysForbidden makes webhook_key/ answer 403, as AWX does for a user without
+	// admin rights on the template.
+	WebhookKeysForbidden bool
+
+	mu      sync.Mutex
+	lastId  int
+	objects map[string]map[int]Object
+	related map[string][]int
+	surveys map[int]any
+
+	webhookKeys map[string]string
+	lastKey     int
+}
+
+// NewServer starts a fake AWX API with a single admin user that any credentials authenticate as.
+func NewServer() *Server {
+	s := &Server{
+		Version:   DefaultVersion,
+		Endpoints: append([]string(nil), DefaultEndpoints...),
+		objects:   map[string]map[int]Object{},
+		related:   map[string][]int{},
+		surveys:   map[int]any{},
+
+		webhookKeys: map[string]string{},
+	}
+	s.Add("users", Object{"username": "admin", "is_superuser": true})
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Add stores obj in collection, as if it had been created through the API, and returns its id.
+// It is used to seed objects the provider only reads, e.g. execution environments.
+func (s *Server) Add(collection string, obj Object) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.create(collection, obj)
+}
+
+// Get returns a copy of the object with the given id in collection.
+func (s *Server) Get(collection string, id int) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[collection][id]
+	if !ok {
+		return nil, false
+	}
+	return clone(obj), true
+}
+
+// List returns a copy of every object in collection whose fields equal fields, ordered by id.
+func (s *Server) List(collection string, fields Object) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query := url.Values{}
+	for key, value := range fields {
+		query.Set(key, fmt.Sprint(value))
+	}
+	results := s.filter(collection, query)
+	for i, obj := range results {
+		results[i] = clone(obj)
+	}
+	return results
+}
+
+// Update merges fields into a stored object, e.g. to simulate it being changed outside of terraform.
+func (s *Server) Update(collection string, id int, fields Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[collection][id]
+	if !ok {
+		return
+	}
+	for key, value := range clone(fields) {
+		obj[key] = value
+	}
+}
+
+// Delete removes an object, e.g. to simulate it being deleted outside of terraform.
+func (s *Server) Delete(collection string, id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects[collection], id)
+}
+
+// Related returns the ids associated to an object's related collection, e.g.
+// Related("job_templates", 1, "labels").
+func (s *Server) Related(collection string, id int, name string) []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int(nil), s.related[relatedKey(collection, id, name)]...)
+}
+
+// WebhookKey returns the webhook key of a job or workflow job template, "" when it has no
+// webhook_service.
+func (s *Server) WebhookKey(collection string, id int) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.webhookKeys[webhookKey(collection, id)]
+}
+
+// Associate adds childId to an object's related collection, e.g. to simulate a team member
+// being added outside of terraform.
+func (s *Server) Associate(collection string, id int, name string, childId int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := relatedKey(collection, id, name)
+	if indexOf(s.related[key], childId) < 0 {
+		s.related[key] = append(s.related[key], childId)
+	}
+}
+
+func (s *Server) create(collection string, obj Object) int {
+	s.lastId++
+	id := s.lastId
+	obj = clone(obj)
+	obj["id"] = id
+	normalize(obj)
+	if setDefaults, ok := serverDefaults[collection]; ok {
+		setDefaults(s, obj)
+	}
+	if roleFields, ok := objectRoles[collection]; ok {
+		s.createObjectRoles(obj, roleFields)
+	}
+	s.updateWebhook(collection, id, obj, "")
+	if s.objects[collection] == nil {
+		s.objects[collection] = map[int]Object{}
+	}
+	s.objects[collection][id] = obj
+	return id
+}
+
+// objectRoles lists the roles AWX creates along with an object of each collection.
+var objectRoles = map[string][]string{
+	"credentials":            {"admin_role", "use_role", "read_role"},
+	"instance_groups":        {"admin_role", "use_role", "read_role"},
+	"inventories":            {"admin_role", "update_role", "adhoc_role", "use_role", "read_role"},
+	"job_templates":          {"admin_role", "execute_role", "read_role"},
+	"organizations":          {"admin_role", "execute_role", "project_admin_role", "inventory_admin_role", "credential_admin_role", "workflow_admin_role", "notification_admin_role", "job_template_admin_role", "execution_environment_admin_role", "auditor_role", "member_role", "read_role", "approval_role"},
+	"projects":               {"admin_role", "use_role", "update_role", "read_role"},
+	"teams":                  {"admin_role", "member_role", "read_role"},
+	"workflow_job_templates": {"admin_role", "execute_role", "read_role", "approval_role"},
+}
+
+// createObjectRoles creates a role for each of roleFields and lists them in obj's
+// summary_fields.object_roles, where the provider resolves them from.
+func (s *Server) createObjectRoles(obj Object, roleFields []string) {
+	roles := Object{}
+	for _, field := range roleFields {
+		name := strings.ToUpper(field[:1]) + strings.ReplaceAll(strings.TrimSuffix(field, "_role")[1:], "_", " ")
+		role := Object{"name": name, "description": fmt.Sprintf("May %s the %s", strings.ToLower(name), obj["name"])}
+		roleId := s.create("roles", role)
+		roles[field] = Object{"id": roleId, "name": name, "description": role["description"]}
+	}
+	summaryFields, _ := obj["summary_fields"].(Object)
+	if summaryFields == nil {
+		summaryFields = Object{}
+		obj["summary_fields"] = summaryFields
+	}
+	summaryFields["object_roles"] = roles
+}
+
+// updateWebhook generates the webhook key of a job or workflow job template when its
+// webhook_service is set or changed from previousService, clears it when it is not set,
+// and lists its webhook receiver in related.webhook_receiver.
+func (s *Server) updateWebhook(collection string, id int, obj Object, previousService string) {
+	if collection != "job_templates" && collection != "workflow_job_templates" {
+		return
+	}
+	key := webhookKey(collection, id)
+
+	receiver := ""
+	if service, _ := obj["webhook_service"].(string); service != "" {
+		if s.webhookKeys[key] == "" || service != previousService {
+			s.rotateWebhookKey(key)
+		}
+		receiver = fmt.Sprintf("%s%s/%d/%s/", BasePath, collection, id, service)
+	} else {
+		delete(s.webhookKeys, key)
+	}
+
+	related, _ := obj["related"].(map[string]any)
+	if related == nil {
+		related = map[string]any{}
+		obj["related"] = related
+	}
+	related["webhook_receiver"] = receiver
+}
+
+func (s *Server) rotateWebhookKey(key string) {
+	s.lastKey++
+	s.webhookKeys[key] = fmt.Sprintf("webhook-key-%d", s.lastKey)
+}
+
+func webhookKey(collection string, id int) string {
+	return fmt.Sprintf("%s/%d", collection, id)
+}
+
+// blankIsNull lists the fields AWX stores as null when they are set to "".
+var blankIsNull = []string{"custom_virtualenv", "webhook_credential"}
+
+// notificationSecrets lists the notification_configuration fields AWX stores encrypted.
+var notificationSecrets = []string{
+	"account_token", "aws_secret_access_key", "aws_session_token", "grafana_key", "password", "service_key", "token",
+}
+
+// mask returns obj as AWX returns it, with the secrets it stores encrypted replaced by "$encrypted$".
+func mask(collection string, obj Object) Object {
+	if collection != "notification_templates" {
+		return obj
+	}
+	config, ok := obj["notification_configuration"].(map[string]any)
+	if !ok {
+		return obj
+	}
+
+	masked := clone(obj)
+	maskedConfig := masked["notification_configuration"].(map[string]any)
+	for _, key := range notificationSecrets {
+		if value, ok := config[key]; ok && value != "" {
+			maskedConfig[key] = "$encrypted$"
+		}
+	}
+	return masked
+}
+
+// serverDefaults fill in the fields AWX computes when an object is created.
+var serverDefaults = map[string]func(s *Server, obj Object){
+	"credentials": func(s *Server, obj Object) {
+		if _, ok := obj["kind"]; !ok {
+			kind := ""
+			if credentialType, ok := obj["credential_type"].(float64); ok {
+				kind, _ = s.objects["credential_types"][int(credentialType)]["namespace"].(string)
+			}
+			obj["kind"] = kind
+		}
+	},
+	"credential_types": func(s *Server, obj Object) {
+		for _, key := range []string{"inputs", "injectors"} {
+			if obj[key] == nil {
+				obj[key] = Object{}
+			}
+		}
+	},
+	"projects": func(s *Server, obj Object) {
+		if _, ok := obj["local_path"]; !ok {
+			obj["local_path"] = fmt.Sprintf("_%d__%s", obj["id"], strings.ReplaceAll(fmt.Sprint(obj["name"]), " ", "_"))
+		}
+		if _, ok := obj["scm_url"]; !ok {
+			obj["scm_url"] = ""
+		}
+	},
+	"workflow_job_template_nodes": func(s *Server, obj Object) {
+		if obj["extra_data"] == nil {
+			obj["extra_data"] = Object{}
+		}
+		if identifier, _ := obj["identifier"].(string); identifier == "" {
+			obj["identifier"] = fmt.Sprintf("node-%d", obj["id"])
+		}
+	},
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/api/" || r.URL.Path == "/api" {
+		writeJSON(w, http.StatusOK, Object{
+			"current_version":    BasePath,
+			"available_versions": Object{"v2": BasePath},
+		})
+		return
+	}
+
+	// like AWX, ping/ does not require authentication
+	if r.URL.Path == BasePath+"ping/" {
+		writeJSON(w, http.StatusOK, Object{"version": s.Version})
+		return
+	}
+
+	if r.Header.Get("Authorization") == "" {
+		writeJSON(w, http.StatusUnauthorized, Object{"detail": "Authentication credentials were not provided."})
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, BasePath) {
+		writeNotFound(w)
+		return
+	}
+
+	var body Object
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeJSON(w, http.StatusBadRequest, Object{"detail": fmt.Sprintf("JSON parse error - %s", err)})
+			return
+		}
+		if body == nil {
+			body = Object{}
+		}
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, BasePath), "/"), "/")
+
+	switch {
+	case segments[0] == "constructed_inventories" && len(segments) <= 2:
+		s.serveConstructedInventories(w, r, segments[1:], body)
+	case len(segments) == 1 && segments[0] == "":
+		s.serveAPIRoot(w, r)
+	case len(segments) == 1 && segments[0] == "me":
+		s.serveMe(w, r)
+	case len(segments) == 3 && segments[0] == "users" && segments[1] == "me":
+		s.serveToken(w, r, body)
+	case len(segments) == 1:
+		s.serveCollection(w, r, segments[0], body)
+	case len(segments) == 2:
+		id, err := strconv.Atoi(segments[1])
+		if err != nil {
+			writeNotFound(w)
+			return
+		}
+		s.serveObject(w, r, segments[0], id, body)
+	case len(segments) == 3:
+		id, err := strconv.Atoi(segments[1])
+		if err != nil {
+			writeNotFound(w)
+			return
+		}
+		s.serveSubresource(w, r, segments[0], id, segments[2], body)
+	default:
+		writeNotFound(w)
+	}
+}
+
+// serveConstructedInventories serves constructed_inventories/ and constructed_inventories/N/
+// from the inventories collection, like AWX does. Inventories created there are of kind
+// constructed, and inventories of any other kind are not found.
+func (s *Server) serveConstructedInventories(w http.ResponseWriter, r *http.Request, segments []string, body Object) {
+	if len(segments) == 0 {
+		if r.Method == http.MethodPost {
+			body["kind"] = "constructed"
+		}
+		query := r.URL.Query()
+		query.Set("kind", "constructed")
+		r.URL.RawQuery = query.Encode()
+		s.serveCollection(w, r, "inventories", body)
+		return
+	}
+
+	id, err := strconv.Atoi(segments[0])
+	if err != nil || s.objects["inventories"][id]["kind"] != "constructed" {
+		writeNotFound(w)
+		return
+	}
+	s.serveObject(w, r, "inventories", id, body)
+}
+
+func (s *Server) serveAPIRoot(w http.ResponseWriter, r *http.Request) {
+	if s.RootUnreadable {
+		writeNotFound(w)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	root := Object{}
+	for _, endpoint := range s.Endpoints {
+		root[endpoint] = BasePath + endpoint + "/"
+	}
+	writeJSON(w, http.StatusOK, root)
+}
+
+func (s *Server) serveMe(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	s.writeList(w, r, []Object{s.objects["users"][1]})
+}
+
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request, body Object) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	body["user"] = 1
+	id := s.create("tokens", body)
+	s.objects["tokens"][id]["token"] = fmt.Sprintf("token-%d", id)
+	writeJSON(w, http.StatusCreated, s.objects["tokens"][id])
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, collection string, body Object) {
+	switch r.Method {
+	case http.MethodGet:
+		s.writeList(w, r, s.filter(collection, r.URL.Query()))
+	case http.MethodPost:
+		id := s.create(collection, body)
+		writeJSON(w, http.StatusCreated, mask(collection, s.objects[collection][id]))
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, collection string, id int, body Object) {
+	obj, ok := s.objects[collection][id]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mask(collection, obj))
+	case http.MethodPut, http.MethodPatch:
+		previousService, _ := obj["webhook_service"].(string)
+		for key, value := range body {
+			if key != "id" {
+				obj[key] = value
+			}
+		}
+		normalize(obj)
+		s.updateWebhook(collection, id, obj, previousService)
+		writeJSON(w, http.StatusOK, mask(collection, obj))
+	case http.MethodDelete:
+		delete(s.objects[collection], id)
+		delete(s.surveys, id)
+		delete(s.webhookKeys, webhookKey(collection, id))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveSubresource(w http.ResponseWriter, r *http.Request, collection string, id int, name string, body Object) {
+	parent, ok := s.objects[collection][id]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	switch {
+	case collection == "job_templates" && name == "survey_spec":
+		s.serveSurveySpec(w, r, id, body)
+	case (collection == "job_templates" || collection == "workflow_job_templates") && name == "webhook_key":
+		s.serveWebhookKey(w, r, webhookKey(collection, id))
+	case collection == "workflow_job_templates" && name == "workflow_nodes":
+		s.serveWorkflowNodes(w, r, id, body)
+	case collection == "workflow_job_template_nodes" && name == "create_approval_template":
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		templateId := s.create("workflow_approval_templates", body)
+		parent["unified_job_template"] = templateId
+		writeJSON(w, http.StatusCreated, s.objects["workflow_approval_templates"][templateId])
+	default:
+		s.serveRelated(w, r, relatedKey(collection, id, name), name, body)
+	}
+}
+
+// serveRelated serves a related collection. Posting {"id": N} associates object N,
+// {"id": N, "disassociate": true} removes it again, and posting an object without
+// an id creates it in the top level collection of the same name and associates it.
+func (s *Server) serveRelated(w http.ResponseWriter, r *http.Request, key, name string, body Object) {
+	switch r.Method {
+	case http.MethodGet:
+		ids := s.related[key]
+		results := make([]Object, 0, len(ids))
+		for _, childId := range ids {
+			child, ok := s.objects[name][childId]
+			if !ok {
+				child = Object{"id": childId}
+			}
+			results = append(results, child)
+		}
+		s.writeList(w, r, results)
+	case http.MethodPost:
+		rawId, hasId := body["id"]
+		if !hasId {
+			childId := s.create(name, body)
+			s.related[key] = append(s.related[key], childId)
+			writeJSON(w, http.StatusCreated, s.objects[name][childId])
+			return
+		}
+
+		childId, ok := rawId.(float64)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, Object{"id": []string{"A valid integer is required."}})
+			return
+		}
+
+		ids := s.related[key]
+		index := indexOf(ids, int(childId))
+
+		if disassociate, _ := body["disassociate"].(bool); disassociate {
+			if index >= 0 {
+				s.related[key] = append(ids[:index:index], ids[index+1:]...)
+			}
+		} else if index < 0 {
+			s.related[key] = append(ids, int(childId))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveSurveySpec(w http.ResponseWriter, r *http.Request, id int, body Object) {
+	switch r.Method {
+	case http.MethodGet:
+		spec, ok := s.surveys[id]
+		if !ok {
+			spec = Object{}
+		}
+		writeJSON(w, http.StatusOK, spec)
+	case http.MethodPost:
+		s.surveys[id] = body
+		writeJSON(w, http.StatusOK, Object{})
+	case http.MethodDelete:
+		delete(s.surveys, id)
+		writeJSON(w, http.StatusOK, Object{})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// serveWebhookKey serves a template's webhook key, posting to it generates a new one.
+func (s *Server) serveWebhookKey(w http.ResponseWriter, r *http.Request, key string) {
+	if s.WebhookKeysForbidden {
+		writeJSON(w, http.StatusForbidden, Object{"detail": "You do not have permission to perform this action."})
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, Object{"webhook_key": s.webhookKeys[key]})
+	case http.MethodPost:
+		s.rotateWebhookKey(key)
+		writeJSON(w, http.StatusCreated, Object{"webhook_key": s.webhookKeys[key]})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveWorkflowNodes(w http.ResponseWriter, r *http.Request, id int, body Object) {
+	switch r.Method {
+	case http.MethodGet:
+		query := url.Values{"workflow_job_template": []string{strconv.Itoa(id)}}
+		s.writeList(w, r, s.filter("workflow_job_template_nodes", query))
+	case http.MethodPost:
+		body["workflow_job_template"] = id
+		nodeId := s.create("workflow_job_template_nodes", body)
+		writeJSON(w, http.StatusCreated, s.objects["workflow_job_template_nodes"][nodeId])
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// filter returns the objects in collection, ordered by id, whose fields equal every
+// query parameter that is not a paging or ordering parameter.
+func (s *Server) filter(collection string, query url.Values) []Object {
+	ids := make([]int, 0, len(s.objects[collection]))
+	for id := range s.objects[collection] {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	results := []Object{}
+	for _, id := range ids {
+		obj := s.objects[collection][id]
+		matches := true
+		for key, values := range query {
+			switch key {
+			case "page", "page_size", "order_by":
+				continue
+			}
+			if value, ok := obj[key]; !ok || fmt.Sprint(value) != values[0] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			results = append(results, mask(collection, obj))
+		}
+	}
+	return results
+}
+
+// writeList writes one page of results in the AWX list format, with next and previous
+// links that keep the request's other query parameters.
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, results []Object) {
+	query := r.URL.Query()
+
+	pageSize := defaultPageSize
+	if value := query.Get("page_size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 {
+			writeJSON(w, http.StatusBadRequest, Object{"detail": "Invalid page_size."})
+			return
+		}
+		pageSize = min(size, maxPageSize)
+	}
+
+	page := 1
+	if value := query.Get("page"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 1 || (number-1)*pageSize >= max(len(results), 1) {
+			writeJSON(w, http.StatusNotFound, Object{"detail": "Invalid page."})
+			return
+		}
+		page = number
+	}
+
+	start := (page - 1) * pageSize
+	end := min(start+pageSize, len(results))
+
+	pageLink := func(number int) any {
+		query.Set("page", strconv.Itoa(number))
+		return r.URL.Path + "?" + query.Encode()
+	}
+
+	var next, previous any
+	if end < len(results) {
+		next = pageLink(page + 1)
+	}
+	if page > 1 {
+		previous = pageLink(page - 1)
+	}
+
+	writeJSON(w, http.StatusOK, Object{
+		"count":    len(results),
+		"next":     next,
+		"previous": previous,
+		"results":  results[start:end],
+	})
+}
+
+func normalize(obj Object) {
+	for _, key := range blankIsNull {
+		if obj[key] == "" {
+			obj[key] = nil
+		}
+	}
+}
+
+func relatedKey(collection string, id int, name string) string {
+	return fmt.Sprintf("%s/%d/%s", collection, id, name)
+}
+
+func indexOf(ids []int, id int) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// clone returns a deep copy of obj, as it would be after a round trip through JSON.
+func clone(obj Object) Object {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var copied Object
+	if err := json.Unmarshal(data, &copied); err != nil {
+		panic(err)
+	}
+	if copied == nil {
+		copied = Object{}
+	}
+	return copied
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, Object{"detail": "Not found."})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusMethodNotAllowed, Object{"detail": fmt.Sprintf("Method \"%s\" not allowed.", r.Method)})
+}
@@ -1,133 +1,259 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	urlParser "net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Number of results requested per page when walking an AWX list endpoint, unless overridden
+// with the provider's page_size attribute. AWX caps this at its MAX_PAGE_SIZE setting (200 by default).
+const defaultPageSize = 200
+
 type AwxClient struct {
-	client   *http.Client
-	endpoint string
-	auth     string
+	client      *http.Client
+	endpoint    string
+	apiBasePath string
+	auth        string
+	pageSize    int
+	retry       RetryPolicy
+	limiter     *requestLimiter
+	// version is the controller version reported by /ping/, empty when it could not be read.
+	version string
+	// endpoints are the top level endpoints the API root advertises, nil when it could not be read.
+	endpoints map[string]bool
+}
+
+// The envelope AWX wraps around every list endpoint response.
+type ListAPIResponse struct {
+	Count    int               `json:"count"`
+	Next     string            `json:"next"`
+	Previous string            `json:"previous"`
+	Results  []json.RawMessage `json:"results"`
 }
 
 // A wrapper for http.NewRequestWithContext() that prepends tower endpoint to URL & sets authorization
 // headers and then makes the actual http request.
 func (c *AwxClient) GenericAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int) (responseBody []byte, statusCode int, errorMessage error) {
-	url = c.endpoint + url
-
-	var body io.Reader
-
-	if requestBody != nil {
-		jsonData, err := json.Marshal(requestBody)
-		if err != nil {
-			errorMessage = fmt.Errorf("unable to marshal requestBody into json: %s", err.Error())
-			return
-		}
-
-		body = strings.NewReader(string(jsonData))
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
-	if err != nil {
-		errorMessage = fmt.Errorf("error generating http request: %v", err)
+	statusCode, responseBody, errorMessage = c.doRequest(ctx, method, url, requestBody)
+	if errorMessage != nil {
 		return
 	}
-	httpReq.Header.Add("Content-Type", "application/json")
-	httpReq.Header.Add("Authorization", c.auth)
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		errorMessage = fmt.Errorf("error doing http request: %v", err)
+	if !slices.Contains(successCodes, statusCode) {
+		errorMessage = newAPIError(method, c.endpoint+url, statusCode, successCodes, responseBody)
 		return
 	}
 
-	var success bool
-	for _, successCode := range successCodes {
-		if httpResp.StatusCode == successCode {
-			success = true
-		}
-	}
-
-	responseBody, err = io.ReadAll(httpResp.Body)
-	statusCode = httpResp.StatusCode
+	return
+}
 
-	if err != nil {
-		errorMessage = fmt.Errorf("unable to read the http response data body. body: %v", responseBody)
+func (c *AwxClient) CreateUpdateAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int) (returnedData map[string]any, statusCode int, errorMessage error) {
+	statusCode, httpRespBodyData, errorMessage := c.doRequest(ctx, method, url, requestBody)
+	if errorMessage != nil {
 		return
 	}
-	defer httpResp.Body.Close()
 
-	if !success {
-		errorMessage = fmt.Errorf("expected %v http response code for API call, got %d with message %s", successCodes, statusCode, responseBody)
+	if !slices.Contains(successCodes, statusCode) {
+		errorMessage = newAPIError(method, c.endpoint+url, statusCode, successCodes, httpRespBodyData)
 		return
 	}
 
+	err := json.Unmarshal(httpRespBodyData, &returnedData)
+	if err != nil {
+		errorMessage = errors.New("unable to unmarshal http request response body to retrieve returnedData")
+		return
+	}
 	return
 }
 
-func (c *AwxClient) CreateUpdateAPIRequest(ctx context.Context, method, url string, requestBody any, successCodes []int) (returnedData map[string]any, statusCode int, errorMessage error) {
+// doRequest prepends the tower endpoint to url, sets the authorization headers and sends the request,
+// retrying transient failures according to c.retry. The response body is read in full and closed.
+func (c *AwxClient) doRequest(ctx context.Context, method, url string, requestBody any) (statusCode int, responseBody []byte, errorMessage error) {
 	url = c.endpoint + url
 
-	var body io.Reader
+	var jsonData []byte
 
 	if requestBody != nil {
-		jsonData, err := json.Marshal(requestBody)
+		var err error
+		jsonData, err = json.Marshal(requestBody)
 		if err != nil {
 			errorMessage = fmt.Errorf("unable to marshal requestBody into json: %s", err.Error())
 			return
 		}
-
-		body = strings.NewReader(string(jsonData))
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
-	if err != nil {
-		errorMessage = fmt.Errorf("error generating http request: %v", err)
+	for attempt := 0; ; attempt++ {
+		var body io.Reader
+		if jsonData != nil {
+			body = bytes.NewReader(jsonData)
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+		if err != nil {
+			errorMessage = fmt.Errorf("error generating http request: %v", err)
+			return
+		}
+		httpReq.Header.Add("Content-Type", "application/json")
+		httpReq.Header.Add("Authorization", c.auth)
+
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			errorMessage = fmt.Errorf("error waiting to send http request: %v", err)
+			return
+		}
+
+		logRequest(ctx, httpReq, attempt, jsonData)
+
+		start := time.Now()
+		httpResp, err := c.client.Do(httpReq)
+		logResponse(ctx, httpReq, attempt, httpResp, err, time.Since(start))
+
+		if attempt < c.retry.MaxRetries && c.retry.shouldRetry(ctx, method, httpResp, err) {
+			wait := c.retry.backoff(attempt, httpResp)
+			tflog.Debug(ctx, "Retrying AWX API request", map[string]any{
+				"method":  method,
+				"url":     url,
+				"attempt": attempt + 1,
+				"wait_ms": wait.Milliseconds(),
+			})
+			if httpResp != nil {
+				_, _ = io.Copy(io.Discard, httpResp.Body)
+				httpResp.Body.Close()
+			}
+			release()
+			if err := sleepContext(ctx, wait); err != nil {
+				errorMessage = fmt.Errorf("error doing http request: %v", err)
+				return
+			}
+			continue
+		}
+
+		if err != nil {
+			release()
+			errorMessage = fmt.Errorf("error doing http request after %d attempt(s): %v", attempt+1, err)
+			return
+		}
+
+		statusCode = httpResp.StatusCode
+		responseBody, err = io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		release()
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to read the http response data body. body: %v", responseBody)
+			return
+		}
+		logResponseBody(ctx, httpReq, statusCode, responseBody)
+
 		return
 	}
-	httpReq.Header.Add("Content-Type", "application/json")
-	httpReq.Header.Add("Authorization", c.auth)
+}
 
-	httpResp, err := c.client.Do(httpReq)
+// ListAPIRequest does a GET against an AWX list endpoint and follows the `next` link of every page,
+// returning the combined results. If a page comes back with a success code other than 200 (e.g. 404)
+// the walk stops and that code is returned with no results so the caller can handle it.
+func (c *AwxClient) ListAPIRequest(ctx context.Context, url string, successCodes []int) (results []json.RawMessage, statusCode int, errorMessage error) {
+	next, err := c.withPageSize(url)
 	if err != nil {
-		errorMessage = fmt.Errorf("error doing http request: %v", err)
+		errorMessage = fmt.Errorf("unable to add page_size to url %s: %v", url, err)
 		return
 	}
 
-	var success bool
-	for _, successCode := range successCodes {
-		if httpResp.StatusCode == successCode {
-			success = true
+	for next != "" {
+		var body []byte
+		body, statusCode, errorMessage = c.GenericAPIRequest(ctx, http.MethodGet, next, nil, successCodes)
+		if errorMessage != nil {
+			return
+		}
+		if statusCode != http.StatusOK {
+			results = nil
+			return
 		}
-	}
 
-	if !success {
-		body, err := io.ReadAll(httpResp.Body)
-		defer httpResp.Body.Close()
+		var page ListAPIResponse
+		err = json.Unmarshal(body, &page)
 		if err != nil {
-			errorMessage = errors.New("unable to read http request response body to retrieve error message")
+			errorMessage = fmt.Errorf("unable to unmarshal list response from %s: %v", next, err)
 			return
 		}
-		errorMessage = fmt.Errorf("expected %v http response code for API call, got %d with message %s", successCodes, httpResp.StatusCode, body)
+
+		results = append(results, page.Results...)
+
+		next, err = relativeURL(page.Next)
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to parse next page url %s: %v", page.Next, err)
+			return
+		}
+	}
+
+	return
+}
+
+// ListChildIds returns the id of every object in an AWX related collection,
+// e.g. APIPath("job_templates/%d/labels/", id), across all pages.
+func (c *AwxClient) ListChildIds(ctx context.Context, url string, successCodes []int) (ids []int, statusCode int, errorMessage error) {
+	results, statusCode, errorMessage := c.ListAPIRequest(ctx, url, successCodes)
+	if errorMessage != nil {
 		return
 	}
 
-	statusCode = httpResp.StatusCode
-	httpRespBodyData, err := io.ReadAll(httpResp.Body)
+	ids = make([]int, 0, len(results))
+
+	for _, result := range results {
+		var child ChildResult
+		err := json.Unmarshal(result, &child)
+		if err != nil {
+			errorMessage = fmt.Errorf("unable to unmarshal child result: %v", err)
+			return
+		}
+		ids = append(ids, child.Id)
+	}
+
+	return
+}
+
+// Sets the page_size query parameter on url unless the caller already set one.
+func (c *AwxClient) withPageSize(url string) (string, error) {
+	u, err := urlParser.Parse(url)
 	if err != nil {
-		errorMessage = errors.New("unable to read http request response body to retrieve id")
-		return
+		return "", err
+	}
+
+	pageSize := c.pageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
 	}
-	err = json.Unmarshal(httpRespBodyData, &returnedData)
+
+	query := u.Query()
+	if query.Get("page_size") == "" {
+		query.Set("page_size", strconv.Itoa(pageSize))
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// AWX returns `next` as a path relative to the server root, but some proxies rewrite it to an
+// absolute url. Either way, strip it back to path and query so it can be passed to GenericAPIRequest.
+func relativeURL(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	u, err := urlParser.Parse(next)
 	if err != nil {
-		errorMessage = errors.New("unable to unmarshal http request response body to retrieve returnedData")
-		return
+		return "", err
 	}
-	return
+
+	return u.RequestURI(), nil
 }
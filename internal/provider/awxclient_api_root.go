@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// The controller API path on a plain AWX install, and the fallback when discovery finds nothing better.
+const defaultAPIBasePath = "/api/v2/"
+
+// The parts of the /api/ root document used to find the controller API. Plain AWX answers with
+// current_version directly. The AAP 2.5+ gateway lists each service under apis, and the controller's
+// own root document then holds current_version, e.g. /api/controller/v2/.
+type apiRootDocument struct {
+	CurrentVersion string            `json:"current_version"`
+	APIs           map[string]string `json:"apis"`
+}
+
+// APIPath returns the path of a controller API resource below the discovered (or configured)
+// API base path, e.g. APIPath("job_templates/%d/labels/", 5) is /api/v2/job_templates/5/labels/
+// on AWX and /api/controller/v2/job_templates/5/labels/ behind an AAP gateway.
+func (c *AwxClient) APIPath(format string, a ...any) string {
+	basePath := c.apiBasePath
+	if basePath == "" {
+		basePath = defaultAPIBasePath
+	}
+
+	return basePath + fmt.Sprintf(format, a...)
+}
+
+// discoverAPIBasePath reads the /api/ root document to find the controller API, following the
+// gateway's controller entry when there is one.
+func (c *AwxClient) discoverAPIBasePath(ctx context.Context) (string, error) {
+	root, err := c.readAPIRoot(ctx, "/api/")
+	if err != nil {
+		return "", err
+	}
+
+	if root.CurrentVersion != "" {
+		return normalizeAPIBasePath(root.CurrentVersion), nil
+	}
+
+	if controllerPath, ok := root.APIs["controller"]; ok {
+		controllerRoot, err := c.readAPIRoot(ctx, normalizeAPIBasePath(controllerPath))
+		if err != nil {
+			return "", err
+		}
+		if controllerRoot.CurrentVersion != "" {
+			return normalizeAPIBasePath(controllerRoot.CurrentVersion), nil
+		}
+	}
+
+	return defaultAPIBasePath, nil
+}
+
+func (c *AwxClient) readAPIRoot(ctx context.Context, path string) (root apiRootDocument, err error) {
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, path, nil, []int{200})
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(body, &root)
+	if err != nil {
+		err = fmt.Errorf("unable to unmarshal api root document from %s: %v", path, err)
+	}
+	return
+}
+
+// readEndpoints returns the top level endpoints advertised by the root document of the controller
+// API, e.g. job_templates or role_definitions.
+func (c *AwxClient) readEndpoints(ctx context.Context) (map[string]bool, error) {
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, c.APIPath(""), nil, []int{200})
+	if err != nil {
+		return nil, err
+	}
+
+	var root map[string]any
+	if err := json.Unmarshal(body, &root); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the api root document from %s: %v", c.APIPath(""), err)
+	}
+
+	endpoints := make(map[string]bool, len(root))
+	for endpoint := range root {
+		endpoints[endpoint] = true
+	}
+	return endpoints, nil
+}
+
+// advertises reports whether the controller API has endpoint. A controller whose endpoints
+// could not be read is assumed to have every endpoint, so plan-time checks do not reject
+// configurations it may well support. Choosing between APIs should not rely on this.
+func (c *AwxClient) advertises(endpoint string) bool {
+	return c.endpoints == nil || c.endpoints[endpoint]
+}
+
+// checkEndpoint adds a plan-time error when the controller does not advertise endpoint,
+// detail explains what the endpoint's absence means and what to do instead.
+func (c *AwxClient) checkEndpoint(endpoint, detail string, diags *diag.Diagnostics) {
+	if c == nil || c.advertises(endpoint) {
+		return
+	}
+
+	diags.AddError(
+		"Endpoint not supported by controller version",
+		fmt.Sprintf("The controller does not advertise /%s/, %s", endpoint, detail))
+}
+
+// Makes sure a base path starts and ends with a single slash so APIPath can append to it.
+func normalizeAPIBasePath(basePath string) string {
+	return "/" + strings.Trim(basePath, "/") + "/"
+}
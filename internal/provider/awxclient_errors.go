@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// APIError is returned by AwxClient when AWX answers a request with a status code the caller did not expect.
+type APIError struct {
+	StatusCode    int
+	ExpectedCodes []int
+	Method        string
+	URL           string
+	Body          []byte
+	// Detail holds AWX's non field specific message, e.g. `detail` on a 403/404 or `__all__` on a validation failure.
+	Detail string
+	// FieldErrors holds AWX's validation messages keyed by the field they apply to,
+	// e.g. {"playbook": ["Playbook not found for project."]}.
+	FieldErrors map[string][]string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("expected %v http response code for %s %s, got %d with message %s", e.ExpectedCodes, e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// Keys AWX uses in an error response that aren't the name of a field on the object.
+var apiErrorDetailKeys = []string{"detail", "error", "msg", "__all__", "non_field_errors"}
+
+func newAPIError(method, url string, statusCode int, expectedCodes []int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode:    statusCode,
+		ExpectedCodes: expectedCodes,
+		Method:        method,
+		URL:           url,
+		Body:          body,
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	var details []string
+
+	for key, value := range parsed {
+		messages := apiErrorMessages(value)
+		if len(messages) == 0 {
+			continue
+		}
+		if slices.Contains(apiErrorDetailKeys, key) {
+			details = append(details, messages...)
+			continue
+		}
+		if apiErr.FieldErrors == nil {
+			apiErr.FieldErrors = make(map[string][]string)
+		}
+		apiErr.FieldErrors[key] = messages
+	}
+
+	apiErr.Detail = strings.Join(details, " ")
+
+	return apiErr
+}
+
+// AWX field errors are usually a list of strings, but can be a bare string or, for fields such as
+// credential inputs, an object of sub field errors.
+func apiErrorMessages(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		messages := make([]string, 0, len(v))
+		for _, item := range v {
+			messages = append(messages, apiErrorMessages(item)...)
+		}
+		return messages
+	case map[string]any:
+		var messages []string
+		for _, key := range sortedKeys(v) {
+			for _, message := range apiErrorMessages(v[key]) {
+				messages = append(messages, fmt.Sprintf("%s: %s", key, message))
+			}
+		}
+		return messages
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Anything that can resolve a path against a resource schema, i.e. tfsdk.Plan or tfsdk.State.
+type schemaPathMatcher interface {
+	PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics)
+}
+
+// addAPIErrorDiagnostics adds err to diags. Field errors in an *APIError that match a top level attribute
+// of the resource are added with AddAttributeError so terraform points at the failing argument. Any
+// remaining errors are reported in a single generic diagnostic, as before.
+func addAPIErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, data schemaPathMatcher, summary string, err error) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		diags.AddError(summary, fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	unmatched := apiErr.Detail != ""
+
+	for _, field := range sortedKeys(apiErr.FieldErrors) {
+		paths, pathDiags := data.PathMatches(ctx, path.MatchRoot(field))
+		if pathDiags.HasError() || len(paths) == 0 {
+			unmatched = true
+			continue
+		}
+
+		diags.AddAttributeError(
+			paths[0],
+			summary,
+			fmt.Sprintf("AWX returned %d for %s %s: %s", apiErr.StatusCode, apiErr.Method, apiErr.URL, strings.Join(apiErr.FieldErrors[field], " ")))
+	}
+
+	if unmatched {
+		diags.AddError(summary, fmt.Sprintf("Error was: %s.", err.Error()))
+	}
+}
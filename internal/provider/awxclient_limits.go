@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter throttles the requests an AwxClient sends. It is shared by every resource and
+// data source configured from the same provider block, so terraform's parallelism and the
+// association resources' loops cannot overwhelm a small controller.
+type requestLimiter struct {
+	// slots caps the number of requests in flight, nil when unlimited.
+	slots chan struct{}
+	// rate caps the number of requests started per second, nil when unlimited.
+	rate *rate.Limiter
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent requests in flight and starting
+// requestsPerSecond requests a second. A value of 0 disables that limit.
+func newRequestLimiter(maxConcurrent int, requestsPerSecond float64) *requestLimiter {
+	l := &requestLimiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		burst := max(1, int(math.Floor(requestsPerSecond)))
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent, or ctx is done. The returned func must be
+// called once the request has completed.
+func (l *requestLimiter) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if l == nil {
+		return
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return release, ctx.Err()
+		}
+	}
+
+	if l.rate != nil {
+		if err = l.rate.Wait(ctx); err != nil {
+			release()
+			return func() {}, err
+		}
+	}
+
+	return
+}
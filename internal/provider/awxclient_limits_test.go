@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiterCapsConcurrency(t *testing.T) {
+	limiter := newRequestLimiter(2, 0)
+
+	var inFlight, peak atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			current := inFlight.Add(1)
+			for {
+				seen := peak.Load()
+				if current <= seen || peak.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if peak.Load() != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak.Load())
+	}
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	limiter := newRequestLimiter(0, 20)
+
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+
+	// the first 20 requests use the burst, the other 5 wait 50ms each
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected 25 requests at 20 per second to take at least 200ms, took %s", elapsed)
+	}
+}
+
+func TestRequestLimiterCanceled(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.acquire(ctx); err == nil {
+		t.Error("expected an error acquiring a slot after the context is done")
+	}
+}
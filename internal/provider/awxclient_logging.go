@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "***"
+
+// secretFields are redacted wherever they appear in a logged request or response body: user
+// passwords, OAuth2 access and refresh tokens and client secrets, webhook keys, and the secret
+// fields of every notification type.
+var secretFields = func() map[string]bool {
+	fields := map[string]bool{
+		"password":      true,
+		"token":         true,
+		"refresh_token": true,
+		"client_secret": true,
+		"webhook_key":   true,
+	}
+	for notificationType := range notificationTypes {
+		for key := range notificationSecretKeys(notificationType) {
+			fields[key] = true
+		}
+	}
+	return fields
+}()
+
+// secretInputFields hold a map of secrets, e.g. credential inputs. Every scalar value
+// in them is redacted, while nested structures such as a credential type's input
+// field definitions are left alone.
+var secretInputFields = map[string]bool{
+	"inputs": true,
+}
+
+// logRequest logs an outgoing request at DEBUG, and its headers and body at TRACE.
+func logRequest(ctx context.Context, req *http.Request, attempt int, body []byte) {
+	fields := map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"attempt": attempt + 1,
+	}
+	tflog.Debug(ctx, "Sending AWX API request", fields)
+
+	headers := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		headers[key] = req.Header.Get(key)
+	}
+	if _, ok := headers["Authorization"]; ok {
+		headers["Authorization"] = redactedValue
+	}
+
+	fields["headers"] = headers
+	fields["body"] = redactBody(body)
+	tflog.Trace(ctx, "AWX API request details", fields)
+}
+
+// logResponse logs the outcome of a request at DEBUG, and the response body at TRACE.
+func logResponse(ctx context.Context, req *http.Request, attempt int, resp *http.Response, err error, duration time.Duration) {
+	fields := map[string]any{
+		"method":      req.Method,
+		"url":         req.URL.String(),
+		"attempt":     attempt + 1,
+		"duration_ms": duration.Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "AWX API request failed", fields)
+		return
+	}
+
+	fields["status_code"] = resp.StatusCode
+	tflog.Debug(ctx, "Received AWX API response", fields)
+}
+
+// logResponseBody logs a response body at TRACE.
+func logResponseBody(ctx context.Context, req *http.Request, statusCode int, body []byte) {
+	tflog.Trace(ctx, "AWX API response body", map[string]any{
+		"method":      req.Method,
+		"url":         req.URL.String(),
+		"status_code": statusCode,
+		"body":        redactBody(body),
+	})
+}
+
+// redactBody returns a JSON body with the values of secretFields and secretInputFields replaced.
+// Bodies that are not JSON objects or arrays are returned unchanged.
+func redactBody(body []byte) string {
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			switch {
+			case secretFields[key] && field != nil && field != "":
+				v[key] = redactedValue
+			case secretInputFields[key]:
+				v[key] = redactInputs(field)
+			default:
+				v[key] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func redactInputs(value any) any {
+	inputs, ok := value.(map[string]any)
+	if !ok {
+		return redactValue(value)
+	}
+	for key, field := range inputs {
+		switch field.(type) {
+		case map[string]any, []any:
+			inputs[key] = redactValue(field)
+		default:
+			if field != nil && field != "" {
+				inputs[key] = redactedValue
+			}
+		}
+	}
+	return inputs
+}
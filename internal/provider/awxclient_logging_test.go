@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"credential inputs": {
+			body:     `{"name":"test","inputs":{"username":"admin","password":"secret","ssh_key_data":"-----BEGIN","become_method":""}}`,
+			expected: `{"inputs":{"become_method":"","password":"***","ssh_key_data":"***","username":"***"},"name":"test"}`,
+		},
+		"credential type inputs": {
+			body:     `{"inputs":{"fields":[{"id":"token","label":"Token","secret":true}]}}`,
+			expected: `{"inputs":{"fields":[{"id":"token","label":"Token","secret":true}]}}`,
+		},
+		"user password": {
+			body:     `{"username":"test","password":"secret"}`,
+			expected: `{"password":"***","username":"test"}`,
+		},
+		"notification configuration token": {
+			body:     `{"notification_configuration":{"channels":["#alerts"],"token":"xoxb"}}`,
+			expected: `{"notification_configuration":{"channels":["#alerts"],"token":"***"}}`,
+		},
+		"oauth2 token": {
+			body:     `{"id":1,"token":"abc","refresh_token":"def","scope":"write"}`,
+			expected: `{"id":1,"refresh_token":"***","scope":"write","token":"***"}`,
+		},
+		"oauth2 client secret": {
+			body:     `{"client_id":"abc","client_secret":"s3cret"}`,
+			expected: `{"client_id":"abc","client_secret":"***"}`,
+		},
+		"nested webhook password": {
+			body:     `{"notification_configuration":{"url":"https://hooks.example.com","username":"awx","password":"s3cret"}}`,
+			expected: `{"notification_configuration":{"password":"***","url":"https://hooks.example.com","username":"awx"}}`,
+		},
+		"webhook key": {
+			body:     `{"webhook_key":"abc123"}`,
+			expected: `{"webhook_key":"***"}`,
+		},
+		"list results": {
+			body:     `{"count":1,"results":[{"username":"test","password":"$encrypted$"}]}`,
+			expected: `{"count":1,"results":[{"password":"***","username":"test"}]}`,
+		},
+		"not json": {
+			body:     `<html>Bad Gateway</html>`,
+			expected: `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(c.body)); got != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestRedactBodyNotificationSecrets(t *testing.T) {
+	for notificationType, fields := range notificationTypes {
+		for _, f := range fields {
+			if !f.secret {
+				continue
+			}
+			t.Run(notificationType+"/"+f.key(), func(t *testing.T) {
+				body := fmt.Sprintf(`{"notification_type":%q,"notification_configuration":{%q:"s3cret"}}`, notificationType, f.key())
+				if got := redactBody([]byte(body)); strings.Contains(got, "s3cret") {
+					t.Errorf("expected %s to be redacted, got %s", f.key(), got)
+				}
+			})
+		}
+	}
+}
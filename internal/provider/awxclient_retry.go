@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy controls how AwxClient retries requests that fail for transient reasons,
+// e.g. a load balancer returning 502 while AWX web pods are rolled.
+type RetryPolicy struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func defaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinWait:    defaultRetryMinWait,
+		MaxWait:    defaultRetryMaxWait,
+	}
+}
+
+// Methods that can safely be sent again when we don't know if the first attempt reached AWX.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides if a request should be attempted again. A 429 means AWX refused the request
+// without acting on it, so it is retried for any method. 5xx responses and network errors (e.g.
+// connection resets) are only retried for idempotent methods, as a POST may already have been applied.
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(method) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before retry number attempt (starting at 0). A Retry-After header
+// on the response wins when present, otherwise the wait grows exponentially from MinWait with jitter
+// so that parallel resources don't retry in lockstep. Both are capped at MaxWait.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, p.MaxWait)
+		}
+	}
+
+	wait := p.MinWait << attempt
+	if wait <= 0 || wait > p.MaxWait {
+		wait = p.MaxWait
+	}
+
+	// equal jitter: always wait at least half, randomize the other half
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+
+	return half + rand.N(half)
+}
+
+// Parses a Retry-After header, which may be a number of seconds or an http date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// Sleeps for d, returning early with the context's error if it is cancelled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+)
+
+func testAwxClient(server *awxmock.Server) *AwxClient {
+	return &AwxClient{
+		client:      server.Client(),
+		endpoint:    server.URL,
+		apiBasePath: awxmock.BasePath,
+		auth:        "Bearer test",
+		pageSize:    defaultPageSize,
+		retry:       defaultRetryPolicy(),
+	}
+}
+
+func TestListAPIRequestFollowsPages(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	for i := 0; i < 7; i++ {
+		server.Add("labels", awxmock.Object{"name": fmt.Sprintf("label-%d", i), "organization": 1})
+	}
+	server.Add("labels", awxmock.Object{"name": "other", "organization": 2})
+
+	client := testAwxClient(server)
+	client.pageSize = 3
+
+	results, statusCode, err := client.ListAPIRequest(context.Background(), client.APIPath("labels/?organization=%d", 1), []int{200})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("expected status code 200, got %d", statusCode)
+	}
+	if len(results) != 7 {
+		t.Errorf("expected 7 results across 3 pages, got %d", len(results))
+	}
+}
+
+func TestListChildIds(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	template := server.Add("job_templates", awxmock.Object{"name": "test"})
+
+	client := testAwxClient(server)
+	client.pageSize = 2
+
+	url := client.APIPath("job_templates/%d/labels/", template)
+	for _, id := range []int{11, 12, 13} {
+		_, _, err := client.GenericAPIRequest(context.Background(), "POST", url, ChildResult{Id: id}, []int{204})
+		if err != nil {
+			t.Fatalf("unexpected error associating %d: %v", id, err)
+		}
+	}
+	_, _, err := client.GenericAPIRequest(context.Background(), "POST", url, ChildDissasocBody{Id: 12, Disassociate: true}, []int{204})
+	if err != nil {
+		t.Fatalf("unexpected error disassociating: %v", err)
+	}
+
+	ids, _, err := client.ListChildIds(context.Background(), url, []int{200})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[11 13]" {
+		t.Errorf("expected ids [11 13], got %v", ids)
+	}
+}
+
+func TestGenericAPIRequestNotFound(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	client := testAwxClient(server)
+
+	_, statusCode, err := client.GenericAPIRequest(context.Background(), "GET", client.APIPath("organizations/%d/", 1000), nil, []int{200, 404})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("expected status code 404, got %d", statusCode)
+	}
+
+	_, _, err = client.GenericAPIRequest(context.Background(), "GET", client.APIPath("organizations/%d/", 1000), nil, []int{200})
+	apiError, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an *APIError, got %T: %v", err, err)
+	}
+	if apiError.StatusCode != 404 || apiError.Detail != "Not found." {
+		t.Errorf("unexpected APIError %+v", apiError)
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTokenScope         = "write"
+	exchangedTokenDescription = "terraform-provider-awx (revoked when the provider exits)"
+
+	// tokenRevokeTimeout bounds RevokeTokens, terraform only waits a couple of
+	// seconds for a provider to exit before killing it.
+	tokenRevokeTimeout = 2 * time.Second
+)
+
+type tokenRequest struct {
+	Description string `json:"description"`
+	Scope       string `json:"scope"`
+	Application *int   `json:"application,omitempty"`
+}
+
+type tokenResponse struct {
+	Id    int    `json:"id"`
+	Token string `json:"token"`
+}
+
+// issuedToken is a token created by exchangeToken along with a client still
+// authenticated with the original credentials, so a read scoped token can
+// also be revoked.
+type issuedToken struct {
+	client *AwxClient
+	id     int
+}
+
+var issuedTokens struct {
+	sync.Mutex
+	tokens []issuedToken
+}
+
+// exchangeToken creates an OAuth2 token for the user the client is authenticated as.
+// A personal access token is created, unless application is set in which case an
+// application token for that OAuth2 application is created. The token is recorded so
+// RevokeTokens deletes it when the provider exits.
+func (c *AwxClient) exchangeToken(ctx context.Context, scope string, application *int) (token string, err error) {
+	url := c.APIPath("users/me/personal_tokens/")
+	if application != nil {
+		url = c.APIPath("users/me/tokens/")
+	}
+
+	body, _, err := c.GenericAPIRequest(ctx, http.MethodPost, url, tokenRequest{
+		Description: exchangedTokenDescription,
+		Scope:       scope,
+		Application: application,
+	}, []int{201})
+	if err != nil {
+		return "", err
+	}
+
+	var created tokenResponse
+	if err := json.Unmarshal(body, &created); err != nil {
+		return "", fmt.Errorf("unable to decode the token response: %w", err)
+	}
+
+	if created.Token == "" {
+		return "", fmt.Errorf("AWX did not return a token from %s", url)
+	}
+
+	revoker := *c
+
+	issuedTokens.Lock()
+	issuedTokens.tokens = append(issuedTokens.tokens, issuedToken{client: &revoker, id: created.Id})
+	issuedTokens.Unlock()
+
+	return created.Token, nil
+}
+
+// RevokeTokens deletes every token the provider created by exchanging a username and
+// password. It is called once the provider server has stopped.
+func RevokeTokens(ctx context.Context) error {
+	issuedTokens.Lock()
+	tokens := issuedTokens.tokens
+	issuedTokens.tokens = nil
+	issuedTokens.Unlock()
+
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenRevokeTimeout)
+	defer cancel()
+
+	var errs []error
+
+	for _, t := range tokens {
+		// a token that is already gone, e.g. expired and cleaned up, needs no revoking
+		_, _, err := t.client.GenericAPIRequest(ctx, http.MethodDelete, t.client.APIPath("tokens/%d/", t.id), nil, []int{204, 404})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to revoke AWX token %d: %w", t.id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AAP's automation controller is versioned separately from AWX (e.g. controller 4.5 ships in
+// AAP 2.4 while AWX is at 24.x), versions with a major below this are controller versions.
+const firstAWXMajorVersion = 9
+
+var versionPattern = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// controllerVersion is the version reported by /ping/, e.g. 24.6.1 for AWX or 4.5.7 for the AAP controller.
+type controllerVersion struct {
+	Major, Minor, Patch int
+}
+
+func (v controllerVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+func (v controllerVersion) isAWX() bool {
+	return v.Major >= firstAWXMajorVersion
+}
+
+func (v controllerVersion) atLeast(other controllerVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// parseControllerVersion parses the leading major.minor[.patch] of a version string,
+// ignoring suffixes such as the .dev13+g1234abc of a development build.
+func parseControllerVersion(version string) (v controllerVersion, ok bool) {
+	match := versionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return v, false
+	}
+	v.Major, _ = strconv.Atoi(match[1])
+	v.Minor, _ = strconv.Atoi(match[2])
+	if match[3] != "" {
+		v.Patch, _ = strconv.Atoi(match[3])
+	}
+	return v, true
+}
+
+// controllerFeature is an API feature and the first AWX and AAP controller versions that have it.
+type controllerFeature struct {
+	Name       string
+	AWX        controllerVersion
+	Controller controllerVersion
+}
+
+var (
+	featurePreventInstanceGroupFallback = controllerFeature{
+		Name:       "prevent_instance_group_fallback",
+		AWX:        controllerVersion{21, 7, 0},
+		Controller: controllerVersion{4, 3, 0},
+	}
+	featurePromptLabelsAndInstanceGroups = controllerFeature{
+		Name:       "prompting for labels and instance groups on launch",
+		AWX:        controllerVersion{21, 11, 0},
+		Controller: controllerVersion{4, 3, 0},
+	}
+	featureTerraformInventorySource = controllerFeature{
+		Name:       "the terraform inventory source",
+		AWX:        controllerVersion{23, 6, 0},
+		Controller: controllerVersion{4, 5, 0},
+	}
+	featureOpenShiftVirtualizationInventorySource = controllerFeature{
+		Name:       "the openshift_virtualization inventory source",
+		AWX:        controllerVersion{24, 3, 0},
+		Controller: controllerVersion{4, 5, 0},
+	}
+)
+
+// readVersion returns the version the controller reports from /ping/, falling back to /config/.
+func (c *AwxClient) readVersion(ctx context.Context) (string, error) {
+	var lastErr error
+
+	for _, endpoint := range []string{"ping/", "config/"} {
+		body, _, err := c.GenericAPIRequest(ctx, http.MethodGet, c.APIPath(endpoint), nil, []int{200})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var document struct {
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(body, &document); err != nil {
+			lastErr = fmt.Errorf("unable to unmarshal %s: %v", endpoint, err)
+			continue
+		}
+		if document.Version != "" {
+			return document.Version, nil
+		}
+		lastErr = fmt.Errorf("%s did not include a version", endpoint)
+	}
+
+	return "", lastErr
+}
+
+// supports reports whether the controller has feature. A controller whose version could not
+// be read or parsed, e.g. a source build, is assumed to support everything.
+func (c *AwxClient) supports(feature controllerFeature) bool {
+	v, ok := parseControllerVersion(c.version)
+	if !ok {
+		return true
+	}
+	if v.isAWX() {
+		return v.atLeast(feature.AWX)
+	}
+	return v.atLeast(feature.Controller)
+}
+
+// unsupportedFeatureDetail describes why feature cannot be used with the configured controller.
+func (c *AwxClient) unsupportedFeatureDetail(feature controllerFeature) string {
+	v, _ := parseControllerVersion(c.version)
+	product, required := "AAP controller", feature.Controller
+	if v.isAWX() {
+		product, required = "AWX", feature.AWX
+	}
+	return fmt.Sprintf("The controller reports version %s, but %s requires %s %s or later.", c.version, feature.Name, product, required)
+}
+
+// checkBoolFeature adds a plan-time error on attribute when it is set to true in config
+// and the controller does not support feature.
+func (c *AwxClient) checkBoolFeature(ctx context.Context, config tfsdk.Config, attribute path.Path, feature controllerFeature, diags *diag.Diagnostics) {
+	if c == nil || c.supports(feature) {
+		return
+	}
+
+	var value types.Bool
+	diags.Append(config.GetAttribute(ctx, attribute, &value)...)
+	if diags.HasError() || !value.ValueBool() {
+		return
+	}
+
+	diags.AddAttributeError(attribute, "Attribute not supported by controller version", c.unsupportedFeatureDetail(feature))
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseControllerVersion(t *testing.T) {
+	cases := map[string]struct {
+		version  string
+		expected controllerVersion
+		ok       bool
+	}{
+		"awx":                {version: "24.6.1", expected: controllerVersion{24, 6, 1}, ok: true},
+		"controller":         {version: "4.5.7", expected: controllerVersion{4, 5, 7}, ok: true},
+		"without patch":      {version: "23.9", expected: controllerVersion{23, 9, 0}, ok: true},
+		"development build":  {version: "24.3.2.dev13+g1234abc", expected: controllerVersion{24, 3, 2}, ok: true},
+		"unparsable version": {version: "devel", ok: false},
+		"empty":              {version: "", ok: false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, ok := parseControllerVersion(c.version)
+			if ok != c.ok {
+				t.Fatalf("expected ok %v, got %v", c.ok, ok)
+			}
+			if v != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, v)
+			}
+		})
+	}
+}
+
+func TestAwxClientSupports(t *testing.T) {
+	cases := map[string]struct {
+		version  string
+		expected bool
+	}{
+		"newer awx":               {version: "24.6.1", expected: true},
+		"same awx":                {version: "23.6.0", expected: true},
+		"older awx":               {version: "23.5.1", expected: false},
+		"newer controller":        {version: "4.6.0", expected: true},
+		"same controller":         {version: "4.5.0", expected: true},
+		"older controller":        {version: "4.4.9", expected: false},
+		"unknown version":         {version: "", expected: true},
+		"unparsable version":      {version: "devel", expected: true},
+		"awx older than the gate": {version: "21.0.0", expected: false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &AwxClient{version: c.version}
+			if got := client.supports(featureTerraformInventorySource); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
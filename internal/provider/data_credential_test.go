@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitCredentialDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_credential_type" "test" {
+  name = "test"
+  kind = "cloud"
+  inputs = jsonencode({
+    fields = [{ id = "token", label = "Token", secret = true, type = "string" }]
+  })
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs          = jsonencode({ token = "secret" })
+}
+
+data "awx_credential" "test" {
+  id = awx_credential.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_credential.test", "name", "awx_credential.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_credential.test", "organization", "awx_credential.test", "organization"),
+					resource.TestCheckResourceAttrPair("data.awx_credential.test", "credential_type", "awx_credential.test", "credential_type"),
+				),
+			},
+		},
+	})
+}
@@ -117,30 +117,30 @@ func (d *CredentialTypeDataSource) Read(ctx context.Context, req datasource.Read
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/credential_types/%d/", id)
+		url = d.client.APIPath("credential_types/%d/", id)
 	}
 	if !data.Name.IsNull() && !data.Kind.IsNull() {
 		// set url for read by name HTTP request
 		name := urlParser.QueryEscape(data.Name.ValueString())
 		kind := urlParser.QueryEscape(data.Kind.ValueString())
-		url = fmt.Sprintf("/api/v2/credential_types/?name=%s&kind=%s", name, kind)
+		url = d.client.APIPath("credential_types/?name=%s&kind=%s", name, kind)
 	}
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
-		return
-	}
-
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	var responseData CredentialTypeAPIModel
 
 	if !data.Id.IsNull() && data.Name.IsNull() {
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		err = json.Unmarshal(body, &responseData)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -151,23 +151,24 @@ func (d *CredentialTypeDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	// If looking up by name, check that there is only one response and extract it.
 	if data.Id.IsNull() && !data.Name.IsNull() && !data.Kind.IsNull() {
-		nameResult := struct {
-			Count   int                      `json:"count"`
-			Results []CredentialTypeAPIModel `json:"results"`
-		}{}
-		err = json.Unmarshal(body, &nameResult)
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Unable to unmarshal response body into result object",
-				fmt.Sprintf("Error:  %v.", err.Error()))
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		if nameResult.Count == 1 {
-			responseData = nameResult.Results[0]
-		} else {
+		if len(results) != 1 {
 			resp.Diagnostics.AddError(
 				"Incorrect number of credential_types returned by name",
-				fmt.Sprintf("Unable to read credential_type as API returned %v credential_types.", nameResult.Count))
+				fmt.Sprintf("Unable to read credential_type as API returned %v credential_types.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into result object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
 			return
 		}
 	}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitCredentialTypeDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_credential_type" "test" {
+  name = "test"
+  kind = "cloud"
+  inputs = jsonencode({
+    fields = [{ id = "token", label = "Token", secret = true, type = "string" }]
+  })
+}
+
+data "awx_credential_type" "by_id" {
+  id = awx_credential_type.test.id
+}
+
+data "awx_credential_type" "by_name" {
+  name = awx_credential_type.test.name
+  kind = awx_credential_type.test.kind
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_credential_type.by_id", "name", "awx_credential_type.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_credential_type.by_id", "inputs", "awx_credential_type.test", "inputs"),
+					resource.TestCheckResourceAttrPair("data.awx_credential_type.by_name", "id", "awx_credential_type.test", "id"),
+				),
+			},
+		},
+	})
+}
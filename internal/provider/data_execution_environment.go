@@ -132,30 +132,30 @@ func (d *ExecutionEnvironmentDataSource) Read(ctx context.Context, req datasourc
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/execution_environments/%d/", id)
+		url = d.client.APIPath("execution_environments/%d/", id)
 	}
 	if !data.Name.IsNull() {
 		// set url for read by name HTTP request
 		name := urlParser.QueryEscape(data.Name.ValueString())
-		url = fmt.Sprintf("/api/v2/execution_environments/?name=%s", name)
-	}
-
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
-		return
-	}
-
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
-		return
+		url = d.client.APIPath("execution_environments/?name=%s", name)
 	}
 
 	var responseData ExecutionEnvironmentDataSourceJson
 
 	if !data.Id.IsNull() && data.Name.IsNull() {
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		err = json.Unmarshal(body, &responseData)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -166,23 +166,24 @@ func (d *ExecutionEnvironmentDataSource) Read(ctx context.Context, req datasourc
 	}
 	// If looking up by name, check that there is only one response and extract it.
 	if data.Id.IsNull() && !data.Name.IsNull() {
-		nameResult := struct {
-			Count   int                                  `json:"count"`
-			Results []ExecutionEnvironmentDataSourceJson `json:"results"`
-		}{}
-		err = json.Unmarshal(body, &nameResult)
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Unable to unmarshal response body into object",
-				fmt.Sprintf("Error:  %v.", err.Error()))
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		if nameResult.Count == 1 {
-			responseData = nameResult.Results[0]
-		} else {
+		if len(results) != 1 {
 			resp.Diagnostics.AddError(
 				"Incorrect number of execution_environments returned by name",
-				fmt.Sprintf("Unable to read execution_environment as API returned %v execution_environments.", nameResult.Count))
+				fmt.Sprintf("Unable to read execution_environment as API returned %v execution_environments.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
 			return
 		}
 	}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitExecutionEnvironmentDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	id := server.Add("execution_environments", awxmock.Object{
+		"name":        "AWX EE (latest)",
+		"description": "",
+		"image":       "quay.io/ansible/awx-ee:latest",
+		"pull":        "always",
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+data "awx_execution_environment" "by_id" {
+  id = %d
+}
+
+data "awx_execution_environment" "by_name" {
+  name = "AWX EE (latest)"
+}
+`, id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awx_execution_environment.by_id", "name", "AWX EE (latest)"),
+					resource.TestCheckResourceAttr("data.awx_execution_environment.by_id", "image", "quay.io/ansible/awx-ee:latest"),
+					resource.TestCheckResourceAttr("data.awx_execution_environment.by_id", "pull", "always"),
+					resource.TestCheckResourceAttrPair("data.awx_execution_environment.by_name", "id", "data.awx_execution_environment.by_id", "id"),
+				),
+			},
+		},
+	})
+}
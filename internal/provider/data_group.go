@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	urlParser "net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupDataSource{}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+type GroupDataSource struct {
+	client *AwxClient
+}
+
+func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get group datasource. Look an inventory group up by `id`, or by `name` and `inventory` as group names are only unique within an inventory.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Group ID.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Group name.",
+				Optional:    true,
+			},
+			"inventory": schema.Int32Attribute{
+				Description: "ID of the inventory the group belongs to.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Group description.",
+				Computed:    true,
+			},
+			"variables": schema.StringAttribute{
+				CustomType:  yamlType{},
+				Description: "The group's variables, its `group_vars`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d GroupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("name"),
+			path.MatchRoot("inventory"),
+		),
+	}
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = configureData
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var responseData GroupAPIModel
+
+	if !data.Id.IsNull() {
+		id, err := strconv.Atoi(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable convert id from string to int.",
+				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
+			return
+		}
+
+		url := d.client.APIPath("groups/%d/", id)
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		err = json.Unmarshal(body, &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error =  %v.", err.Error()))
+			return
+		}
+	}
+	// If looking up by name, check that there is only one group with that name in the inventory and extract it.
+	if data.Id.IsNull() && !data.Name.IsNull() {
+		name := urlParser.QueryEscape(data.Name.ValueString())
+		url := d.client.APIPath("groups/?name=%s&inventory=%d", name, data.Inventory.ValueInt32())
+
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+		if len(results) != 1 {
+			resp.Diagnostics.AddError(
+				"Incorrect number of groups returned by name",
+				fmt.Sprintf("Unable to read group as API returned %v groups.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(strconv.Itoa(responseData.Id))
+	data.Name = types.StringValue(responseData.Name)
+	data.Inventory = types.Int32Value(int32(responseData.Inventory))
+
+	if responseData.Description != "" {
+		data.Description = types.StringValue(responseData.Description)
+	}
+
+	if responseData.Variables != "" {
+		data.Variables = newYAMLValue(responseData.Variables)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitGroupDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_inventory" "other" {
+  name         = "other"
+  organization = awx_organization.test.id
+}
+
+resource "awx_group" "test" {
+  name        = "webservers"
+  description = "test group"
+  inventory   = awx_inventory.test.id
+  variables   = "http_port: 80"
+}
+
+resource "awx_group" "other" {
+  name        = "webservers"
+  description = "same name, other inventory"
+  inventory   = awx_inventory.other.id
+}
+
+data "awx_group" "by_id" {
+  id = awx_group.test.id
+}
+
+data "awx_group" "by_name" {
+  name      = awx_group.test.name
+  inventory = awx_inventory.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_group.by_id", "name", "awx_group.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_group.by_id", "description", "awx_group.test", "description"),
+					resource.TestCheckResourceAttrPair("data.awx_group.by_id", "inventory", "awx_group.test", "inventory"),
+					resource.TestCheckResourceAttrPair("data.awx_group.by_id", "variables", "awx_group.test", "variables"),
+					resource.TestCheckResourceAttrPair("data.awx_group.by_name", "id", "awx_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.awx_group.by_name", "description", "awx_group.test", "description"),
+				),
+			},
+		},
+	})
+}
@@ -112,30 +112,30 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/hosts/%d/", id)
+		url = d.client.APIPath("hosts/%d/", id)
 	}
 	if !data.Name.IsNull() && !data.Inventory.IsNull() {
 		// set url for read by name HTTP request
 		name := urlParser.QueryEscape(data.Name.ValueString())
-		url = fmt.Sprintf("/api/v2/hosts/?name=%s&inventory=%d", name, data.Inventory.ValueInt32())
-	}
-
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
-		return
-	}
-
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
-		return
+		url = d.client.APIPath("hosts/?name=%s&inventory=%d", name, data.Inventory.ValueInt32())
 	}
 
 	var responseData HostAPIModel
 
 	if !data.Id.IsNull() && data.Name.IsNull() && data.Inventory.IsNull() {
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		err = json.Unmarshal(body, &responseData)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -145,24 +145,25 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	}
 	// If looking up by name, check that there is only one response and extract it.
-	if data.Id.IsNull() && !data.Name.IsNull() && data.Inventory.IsNull() {
-		nameResult := struct {
-			Count   int            `json:"count"`
-			Results []HostAPIModel `json:"results"`
-		}{}
-		err = json.Unmarshal(body, &nameResult)
+	if data.Id.IsNull() && !data.Name.IsNull() && !data.Inventory.IsNull() {
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Unable to unmarshal response body into object",
-				fmt.Sprintf("Error:  %v.", err.Error()))
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		if nameResult.Count == 1 {
-			responseData = nameResult.Results[0]
-		} else {
+		if len(results) != 1 {
 			resp.Diagnostics.AddError(
 				"Incorrect number of hosts returned by name",
-				fmt.Sprintf("Unable to read host as API returned %v hosts.", nameResult.Count))
+				fmt.Sprintf("Unable to read host as API returned %v hosts.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
 			return
 		}
 	}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitHostDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  description  = "test inventory"
+  organization = awx_organization.test.id
+}
+
+resource "awx_host" "test" {
+  name      = "host.example.com"
+  inventory = awx_inventory.test.id
+}
+
+data "awx_host" "by_id" {
+  id = awx_host.test.id
+}
+
+data "awx_host" "by_name" {
+  name      = awx_host.test.name
+  inventory = awx_inventory.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_host.by_id", "name", "awx_host.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_host.by_id", "inventory", "awx_host.test", "inventory"),
+					resource.TestCheckResourceAttrPair("data.awx_host.by_name", "id", "awx_host.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -127,30 +127,30 @@ func (d *InstanceGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/instance_groups/%d/", id)
+		url = d.client.APIPath("instance_groups/%d/", id)
 	}
 	if !data.Name.IsNull() {
 		// set url for read by name HTTP request
 		name := urlParser.QueryEscape(data.Name.ValueString())
-		url = fmt.Sprintf("/api/v2/instance_groups/?name=%s", name)
-	}
-
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
-		return
-	}
-
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
-		return
+		url = d.client.APIPath("instance_groups/?name=%s", name)
 	}
 
 	var responseData InstanceGroupDataSourceJson
 
 	if !data.Id.IsNull() && data.Name.IsNull() {
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		err = json.Unmarshal(body, &responseData)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -161,23 +161,24 @@ func (d *InstanceGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	// If looking up by name, check that there is only one response and extract it.
 	if data.Id.IsNull() && !data.Name.IsNull() {
-		nameResult := struct {
-			Count   int                           `json:"count"`
-			Results []InstanceGroupDataSourceJson `json:"results"`
-		}{}
-		err = json.Unmarshal(body, &nameResult)
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Unable to unmarshal response body into object",
-				fmt.Sprintf("Error:  %v.", err.Error()))
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		if nameResult.Count == 1 {
-			responseData = nameResult.Results[0]
-		} else {
+		if len(results) != 1 {
 			resp.Diagnostics.AddError(
 				"Incorrect number of instance_groups returned by name",
-				fmt.Sprintf("Unable to read instance_group as API returned %v instance_groups.", nameResult.Count))
+				fmt.Sprintf("Unable to read instance_group as API returned %v instance_groups.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
 			return
 		}
 	}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitInstanceGroupDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	id := server.Add("instance_groups", awxmock.Object{
+		"name":                       "default",
+		"max_concurrent_jobs":        0,
+		"max_forks":                  0,
+		"policy_instance_minimum":    1,
+		"policy_instance_percentage": 100,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+data "awx_instance_group" "by_id" {
+  id = %d
+}
+
+data "awx_instance_group" "by_name" {
+  name = "default"
+}
+`, id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awx_instance_group.by_id", "name", "default"),
+					resource.TestCheckResourceAttr("data.awx_instance_group.by_id", "policy_instance_minimum", "1"),
+					resource.TestCheckResourceAttrPair("data.awx_instance_group.by_name", "id", "data.awx_instance_group.by_id", "id"),
+				),
+			},
+		},
+	})
+}
@@ -99,7 +99,7 @@ func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	url = fmt.Sprintf("/api/v2/inventories/%d/", id)
+	url = d.client.APIPath("inventories/%d/", id)
 	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
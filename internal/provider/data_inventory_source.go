@@ -147,7 +147,7 @@ func (d *InventorySourceDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	url = fmt.Sprintf("/api/v2/inventory_sources/%d/", id)
+	url = d.client.APIPath("inventory_sources/%d/", id)
 	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitInventorySourceDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  description  = "test project"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_inventory_source" "test" {
+  name           = "test"
+  inventory      = awx_inventory.test.id
+  source         = "scm"
+  source_project = awx_project.test.id
+  source_path    = "inventory.yml"
+}
+
+data "awx_inventory_source" "test" {
+  id = awx_inventory_source.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_inventory_source.test", "name", "awx_inventory_source.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_inventory_source.test", "source", "awx_inventory_source.test", "source"),
+					resource.TestCheckResourceAttrPair("data.awx_inventory_source.test", "source_project", "awx_inventory_source.test", "source_project"),
+				),
+			},
+		},
+	})
+}
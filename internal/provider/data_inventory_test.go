@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitInventoryDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  description  = "test inventory"
+  organization = awx_organization.test.id
+}
+
+data "awx_inventory" "test" {
+  id = awx_inventory.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_inventory.test", "name", "awx_inventory.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_inventory.test", "description", "awx_inventory.test", "description"),
+					resource.TestCheckResourceAttrPair("data.awx_inventory.test", "organization", "awx_inventory.test", "organization"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitJobTemplateDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  description  = "test project"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+data "awx_job_template" "by_id" {
+  id = awx_job_template.test.id
+}
+
+data "awx_job_template" "by_name" {
+  name = awx_job_template.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_job_template.by_id", "name", "awx_job_template.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_job_template.by_id", "playbook", "awx_job_template.test", "playbook"),
+					resource.TestCheckResourceAttrPair("data.awx_job_template.by_id", "ask_inventory_on_launch", "awx_job_template.test", "ask_inventory_on_launch"),
+					resource.TestCheckResourceAttrPair("data.awx_job_template.by_name", "id", "awx_job_template.test", "id"),
+				),
+			},
+		},
+	})
+}
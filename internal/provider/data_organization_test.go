@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitOrganizationDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+data "awx_organization" "by_id" {
+  id = awx_organization.test.id
+}
+
+data "awx_organization" "by_name" {
+  name = awx_organization.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_organization.by_id", "name", "awx_organization.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_organization.by_id", "max_hosts", "awx_organization.test", "max_hosts"),
+					resource.TestCheckResourceAttrPair("data.awx_organization.by_name", "id", "awx_organization.test", "id"),
+					resource.TestCheckResourceAttrPair("data.awx_organization.by_name", "description", "awx_organization.test", "description"),
+				),
+			},
+		},
+	})
+}
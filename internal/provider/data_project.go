@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	urlParser "net/url"
 	"strconv"
 
@@ -151,13 +150,13 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/projects/?id=%d", id)
+		url = d.client.APIPath("projects/?id=%d", id)
 	}
 	if !data.Name.IsNull() {
-		url = fmt.Sprintf("/api/v2/projects/?name=%s", urlParser.QueryEscape(data.Name.ValueString()))
+		url = d.client.APIPath("projects/?name=%s", urlParser.QueryEscape(data.Name.ValueString()))
 	}
 
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -165,33 +164,22 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if len(results) != 1 {
+		resp.Diagnostics.AddError(
+			"Incorrect number of projects returned",
+			fmt.Sprintf("Unable to read project as API returned %v projects.", len(results)))
 		return
 	}
 
 	var responseData ProjectAPIModel
 
-	countResult := struct {
-		Count   int               `json:"count"`
-		Results []ProjectAPIModel `json:"results"`
-	}{}
-
-	err = json.Unmarshal(body, &countResult)
+	err = json.Unmarshal(results[0], &responseData)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to unmarshal response body into object",
 			fmt.Sprintf("Error:  %v.", err.Error()))
 		return
 	}
-	if countResult.Count == 1 {
-		responseData = countResult.Results[0]
-	} else {
-		resp.Diagnostics.AddError(
-			"Incorrect number of projects returned",
-			fmt.Sprintf("Unable to read project as API returned %v projects.", countResult.Count))
-		return
-	}
 
 	idAsString := strconv.Itoa(responseData.Id)
 	data.Id = types.StringValue(idAsString)
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitProjectDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  description  = "test project"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+data "awx_project" "by_id" {
+  id = awx_project.test.id
+}
+
+data "awx_project" "by_name" {
+  name = awx_project.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_project.by_id", "name", "awx_project.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_project.by_id", "scm_url", "awx_project.test", "scm_url"),
+					resource.TestCheckResourceAttrPair("data.awx_project.by_name", "id", "awx_project.test", "id"),
+				),
+			},
+		},
+	})
+}
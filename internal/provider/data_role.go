@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+type RoleDataSource struct {
+	client *AwxClient
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get role datasource. Looks up one of the roles of an object, e.g. the `execute_role` of a job template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Role ID.",
+				Computed:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the object the role belongs to. Options: `%s`.", strings.Join(roleResourceTypeNames(), "`, `")),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleResourceTypeNames()...),
+				},
+			},
+			"resource_id": schema.Int32Attribute{
+				Description: "ID of the object the role belongs to.",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The role's field on the object, e.g. `admin_role`, `execute_role`, `read_role`, `use_role` or `update_role`.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Role display name, e.g. `Execute`.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Role description.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = configureData
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, statusCode, err := d.client.lookupObjectRole(ctx, data.ResourceType.ValueString(), int(data.ResourceId.ValueInt32()), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to look up role",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.Diagnostics.AddError(
+			"Unable to look up role",
+			fmt.Sprintf("%s %d does not exist.", data.ResourceType.ValueString(), data.ResourceId.ValueInt32()))
+		return
+	}
+
+	data.Id = types.StringValue(strconv.Itoa(role.Id))
+	data.Name = types.StringValue(role.Name)
+	data.Description = types.StringValue(role.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
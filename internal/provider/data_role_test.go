@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitRoleDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+	server.Add("role_definitions", awxmock.Object{"name": "Organization Member", "content_type": "awx.organization", "managed": true})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_user" "test" {
+  username = "test"
+  password = "secret"
+}
+
+data "awx_role" "test" {
+  resource_type = "organization"
+  resource_id   = awx_organization.test.id
+  role          = "member_role"
+}
+
+resource "awx_role_assignment" "test" {
+  resource_type = "organization"
+  resource_id   = awx_organization.test.id
+  role          = "member_role"
+  user_id       = awx_user.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.awx_role.test", "id"),
+					resource.TestCheckResourceAttr("data.awx_role.test", "name", "Member"),
+					resource.TestCheckResourceAttrPair("data.awx_role.test", "id", "awx_role_assignment.test", "role_id"),
+				),
+			},
+		},
+	})
+}
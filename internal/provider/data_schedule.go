@@ -95,7 +95,7 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	url = fmt.Sprintf("/api/v2/schedules/%d/", id)
+	url = d.client.APIPath("schedules/%d/", id)
 	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitScheduleDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+  max_hosts   = 5
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  description  = "test project"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_schedule" "test" {
+  name                 = "test"
+  unified_job_template = awx_job_template.test.id
+  rrule                = "DTSTART;TZID=UTC:20250101T000000 RRULE:FREQ=DAILY;INTERVAL=1"
+}
+
+data "awx_schedule" "test" {
+  id = awx_schedule.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_schedule.test", "name", "awx_schedule.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_schedule.test", "rrule", "awx_schedule.test", "rrule"),
+					resource.TestCheckResourceAttrPair("data.awx_schedule.test", "unified_job_template", "awx_schedule.test", "unified_job_template"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	urlParser "net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TeamDataSource{}
+
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+type TeamDataSource struct {
+	client *AwxClient
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get team datasource. Look a team up by `id`, or by `name` and `organization` as team names are only unique within an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Team ID.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Team name.",
+				Optional:    true,
+			},
+			"organization": schema.Int32Attribute{
+				Description: "Organization ID the team lives in.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Team description.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d TeamDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("name"),
+			path.MatchRoot("organization"),
+		),
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = configureData
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var responseData TeamAPIModel
+
+	if !data.Id.IsNull() {
+		id, err := strconv.Atoi(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable convert id from string to int.",
+				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
+			return
+		}
+
+		url := d.client.APIPath("teams/%d/", id)
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		err = json.Unmarshal(body, &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error =  %v.", err.Error()))
+			return
+		}
+	}
+	// If looking up by name, check that there is only one team with that name in the organization and extract it.
+	if data.Id.IsNull() && !data.Name.IsNull() {
+		name := urlParser.QueryEscape(data.Name.ValueString())
+		url := d.client.APIPath("teams/?name=%s&organization=%d", name, data.Organization.ValueInt32())
+
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+		if len(results) != 1 {
+			resp.Diagnostics.AddError(
+				"Incorrect number of teams returned by name",
+				fmt.Sprintf("Unable to read team as API returned %v teams.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(strconv.Itoa(responseData.Id))
+	data.Name = types.StringValue(responseData.Name)
+	data.Organization = types.Int32Value(int32(responseData.Organization))
+
+	if responseData.Description != "" {
+		data.Description = types.StringValue(responseData.Description)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitTeamDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_organization" "other" {
+  name = "other"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  description  = "test team"
+  organization = awx_organization.test.id
+}
+
+resource "awx_team" "other" {
+  name         = "test"
+  description  = "same name, other organization"
+  organization = awx_organization.other.id
+}
+
+data "awx_team" "by_id" {
+  id = awx_team.test.id
+}
+
+data "awx_team" "by_name" {
+  name         = awx_team.test.name
+  organization = awx_organization.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_team.by_id", "name", "awx_team.test", "name"),
+					resource.TestCheckResourceAttrPair("data.awx_team.by_id", "description", "awx_team.test", "description"),
+					resource.TestCheckResourceAttrPair("data.awx_team.by_id", "organization", "awx_team.test", "organization"),
+					resource.TestCheckResourceAttrPair("data.awx_team.by_name", "id", "awx_team.test", "id"),
+					resource.TestCheckResourceAttrPair("data.awx_team.by_name", "description", "awx_team.test", "description"),
+				),
+			},
+		},
+	})
+}
@@ -116,30 +116,30 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 			return
 		}
-		url = fmt.Sprintf("/api/v2/users/%d/", id)
+		url = d.client.APIPath("users/%d/", id)
 	}
 	if !data.Username.IsNull() {
 		// set url for read by username HTTP request
 		name := urlParser.QueryEscape(data.Username.ValueString())
-		url = fmt.Sprintf("/api/v2/users/?username=%s", name)
-	}
-
-	body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
-		return
-	}
-
-	if statusCode == 404 {
-		resp.State.RemoveResource(ctx)
-		return
+		url = d.client.APIPath("users/?username=%s", name)
 	}
 
 	var responseData UserAPIModel
 
 	if !data.Id.IsNull() && data.Username.IsNull() {
+		body, statusCode, err := d.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		err = json.Unmarshal(body, &responseData)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -150,23 +150,24 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 	// If looking up by usernamename, check that there is only one response and extract it.
 	if data.Id.IsNull() && !data.Username.IsNull() {
-		nameResult := struct {
-			Count   int            `json:"count"`
-			Results []UserAPIModel `json:"results"`
-		}{}
-		err = json.Unmarshal(body, &nameResult)
+		results, _, err := d.client.ListAPIRequest(ctx, url, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Unable to unmarshal response body into object",
-				fmt.Sprintf("Error:  %v.", err.Error()))
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
 			return
 		}
-		if nameResult.Count == 1 {
-			responseData = nameResult.Results[0]
-		} else {
+		if len(results) != 1 {
 			resp.Diagnostics.AddError(
 				"Incorrect number of users returned by name",
-				fmt.Sprintf("Unable to read user as API returned %v users.", nameResult.Count))
+				fmt.Sprintf("Unable to read user as API returned %v users.", len(results)))
+			return
+		}
+		err = json.Unmarshal(results[0], &responseData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to unmarshal response body into object",
+				fmt.Sprintf("Error:  %v.", err.Error()))
 			return
 		}
 	}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitUserDataSource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_user" "test" {
+  username   = "test"
+  password   = "secret"
+  first_name = "Test"
+  email      = "test@example.com"
+}
+
+data "awx_user" "by_id" {
+  id = awx_user.test.id
+}
+
+data "awx_user" "by_username" {
+  username = awx_user.test.username
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.awx_user.by_id", "username", "awx_user.test", "username"),
+					resource.TestCheckResourceAttrPair("data.awx_user.by_id", "email", "awx_user.test", "email"),
+					resource.TestCheckResourceAttrPair("data.awx_user.by_username", "id", "awx_user.test", "id"),
+				),
+			},
+		},
+	})
+}
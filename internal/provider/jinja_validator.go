@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jinjaTemplateValidator{}
+
+// jinjaFields is a tree of the variables a Jinja template may use. A nil subtree means
+// the variable's own attributes are not checked, e.g. a string or a free form dict.
+type jinjaFields map[string]jinjaFields
+
+func jinjaLeaves(names ...string) jinjaFields {
+	fields := make(jinjaFields, len(names))
+	for _, name := range names {
+		fields[name] = nil
+	}
+	return fields
+}
+
+// awxJobFields are the fields of a job AWX passes to notification templates, its
+// JobNotificationMixin.JOB_FIELDS_ALLOWED_LIST.
+var awxJobFields = func() jinjaFields {
+	fields := jinjaLeaves(
+		"id", "type", "url", "created", "modified", "name", "description", "job_type", "playbook", "forks", "limit",
+		"verbosity", "job_tags", "force_handlers", "skip_tags", "start_at_task", "timeout", "use_fact_cache", "launch_type",
+		"status", "failed", "started", "finished", "elapsed", "job_explanation", "execution_node", "controller_node",
+		"allow_simultaneous", "scm_revision", "diff_mode", "job_slice_number", "job_slice_count", "custom_virtualenv",
+		"approval_status", "approval_node_name", "workflow_url", "scm_branch", "artifacts",
+	)
+	fields["host_status_counts"] = jinjaLeaves("skipped", "ok", "changed", "failed", "failures", "dark", "processed", "rescued", "ignored")
+	fields["summary_fields"] = jinjaFields{
+		"inventory": jinjaLeaves("id", "name", "description", "has_active_failures", "total_hosts", "hosts_with_active_failures",
+			"total_groups", "has_inventory_sources", "total_inventory_sources", "inventory_sources_with_failures", "organization_id", "kind"),
+		"project":              jinjaLeaves("id", "name", "description", "status", "scm_type"),
+		"job_template":         jinjaLeaves("id", "name", "description"),
+		"unified_job_template": jinjaLeaves("id", "name", "description", "unified_job_type"),
+		"instance_group":       jinjaLeaves("name", "id"),
+		"created_by":           jinjaLeaves("id", "username", "first_name", "last_name"),
+		"schedule":             jinjaLeaves("id", "name", "description", "next_run"),
+		"labels":               jinjaLeaves("count", "results"),
+	}
+	return fields
+}()
+
+// jobNotificationVariables are the variables of the started, success and error messages.
+var jobNotificationVariables = jinjaFields{
+	"job":               awxJobFields,
+	"job_friendly_name": nil,
+	"job_metadata":      nil,
+	"url":               nil,
+}
+
+// approvalNotificationVariables are the variables of the workflow approval messages.
+var approvalNotificationVariables = jinjaFields{
+	"approval_node_name": nil,
+	"approval_status":    nil,
+	"job_metadata":       nil,
+	"workflow_url":       nil,
+}
+
+// jinjaGlobals are the names Jinja itself defines in every template.
+var jinjaGlobals = map[string]bool{
+	"range": true, "lipsum": true, "dict": true, "cycler": true, "joiner": true, "namespace": true, "loop": true,
+	"true": true, "false": true, "none": true, "True": true, "False": true, "None": true,
+	"and": true, "or": true, "not": true, "in": true, "is": true, "if": true, "else": true,
+}
+
+var (
+	jinjaTagStart   = regexp.MustCompile(`\{[{%#]`)
+	jinjaEndRaw     = regexp.MustCompile(`\{%[-+]?\s*endraw\s*[-+]?%\}`)
+	jinjaIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*`)
+	jinjaNames      = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+	jinjaAssignment = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\s*=(?:[^=]|$)`)
+)
+
+// jinjaTemplateValidator checks a Jinja template is well formed and only uses the variables
+// AWX provides, so a typo fails the plan rather than the notification.
+type jinjaTemplateValidator struct {
+	variables jinjaFields
+}
+
+func (v jinjaTemplateValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a Jinja template using only the variables %s", strings.Join(sortedKeys(v.variables), ", "))
+}
+
+func (v jinjaTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be a Jinja template using only the variables `%s`", strings.Join(sortedKeys(v.variables), "`, `"))
+}
+
+func (v jinjaTemplateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Jinja template",
+			err.Error(),
+		)
+	}
+}
+
+// check walks the template's tags, keeping track of blocks and the variables they define,
+// and checks every variable an expression uses.
+func (v jinjaTemplateValidator) check(template string) error {
+	locals := map[string]bool{}
+	var blocks []string
+
+	rest := template
+	for {
+		loc := jinjaTagStart.FindStringIndex(rest)
+		if loc == nil {
+			break
+		}
+		open := rest[loc[0]:loc[1]]
+		closing := map[string]string{"{{": "}}", "{%": "%}", "{#": "#}"}[open]
+
+		end := strings.Index(rest[loc[1]:], closing)
+		if end < 0 {
+			return fmt.Errorf("%q is never closed with %q", open, closing)
+		}
+		inner := strings.Trim(rest[loc[1]:loc[1]+end], "-+")
+		rest = rest[loc[1]+end+len(closing):]
+
+		switch open {
+		case "{{":
+			if err := v.checkExpression(inner, locals); err != nil {
+				return fmt.Errorf("{{%s}}: %w", inner, err)
+			}
+		case "{%":
+			fields := strings.Fields(inner)
+			if len(fields) == 0 {
+				return fmt.Errorf("empty {%% %%} tag")
+			}
+			tag, args := fields[0], strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(inner), fields[0]))
+
+			var err error
+			switch tag {
+			case "if":
+				blocks = append(blocks, tag)
+				err = v.checkExpression(args, locals)
+			case "elif":
+				if len(blocks) == 0 || blocks[len(blocks)-1] != "if" {
+					return fmt.Errorf("{%% elif %%} outside of an {%% if %%} block")
+				}
+				err = v.checkExpression(args, locals)
+			case "else":
+				if len(blocks) == 0 || (blocks[len(blocks)-1] != "if" && blocks[len(blocks)-1] != "for") {
+					return fmt.Errorf("{%% else %%} outside of an {%% if %%} or {%% for %%} block")
+				}
+			case "for":
+				targets, iterable, found := strings.Cut(args, " in ")
+				if !found {
+					return fmt.Errorf("{%%%s%%}: expected \"for <name> in <expression>\"", inner)
+				}
+				blocks = append(blocks, tag)
+				iterable, _, _ = strings.Cut(iterable, " if ")
+				err = v.checkExpression(strings.TrimSuffix(strings.TrimSpace(iterable), " recursive"), locals)
+				for _, target := range strings.Split(targets, ",") {
+					locals[strings.Trim(strings.TrimSpace(target), "()")] = true
+				}
+			case "set", "with":
+				names, value, found := strings.Cut(args, "=")
+				if tag == "with" || !found {
+					// {% with %} and the block assignment {% set name %}...{% endset %} have an end tag
+					blocks = append(blocks, tag)
+				}
+				for _, name := range strings.Split(names, ",") {
+					locals[strings.Trim(strings.TrimSpace(name), "()")] = true
+				}
+				if tag == "with" {
+					// {% with a = x, b = y %} assigns several names
+					for _, assignment := range jinjaAssignment.FindAllStringSubmatch(args, -1) {
+						locals[assignment[1]] = true
+					}
+				}
+				if found {
+					err = v.checkExpression(value, locals)
+				}
+			case "macro", "call", "filter", "block":
+				blocks = append(blocks, tag)
+				// the names a macro or call block defines are not checked
+				for _, name := range jinjaNames.FindAllString(args, -1) {
+					locals[name] = true
+				}
+			case "raw":
+				endRaw := jinjaEndRaw.FindStringIndex(rest)
+				if endRaw == nil {
+					return fmt.Errorf("{%% raw %%} block is never closed with {%% endraw %%}")
+				}
+				rest = rest[endRaw[1]:]
+			default:
+				if !strings.HasPrefix(tag, "end") {
+					return fmt.Errorf("unknown tag {%% %s %%}", tag)
+				}
+				if len(blocks) == 0 || "end"+blocks[len(blocks)-1] != tag {
+					return fmt.Errorf("unexpected {%% %s %%}", tag)
+				}
+				blocks = blocks[:len(blocks)-1]
+			}
+			if err != nil {
+				return fmt.Errorf("{%%%s%%}: %w", inner, err)
+			}
+		}
+	}
+
+	if len(blocks) > 0 {
+		return fmt.Errorf("{%% %s %%} block is never closed with {%% end%s %%}", blocks[len(blocks)-1], blocks[len(blocks)-1])
+	}
+	return nil
+}
+
+// checkExpression checks every variable an expression uses is a local, a Jinja global,
+// or one of the validator's variables and its known attributes. Filter and test names,
+// string literals and keyword argument names are skipped.
+func (v jinjaTemplateValidator) checkExpression(expression string, locals map[string]bool) error {
+	previous := ""
+	for i := 0; i < len(expression); {
+		c := expression[i]
+
+		switch {
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(expression) && expression[end] != c {
+				if expression[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			i = end + 1
+			previous = "literal"
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+			path := []string{jinjaIdentifier.FindString(expression[i:])}
+			i += len(path[0])
+			for i < len(expression) && expression[i] == '.' {
+				attribute := jinjaIdentifier.FindString(expression[i+1:])
+				if attribute == "" {
+					break
+				}
+				path = append(path, attribute)
+				i += 1 + len(attribute)
+			}
+
+			next := strings.TrimLeft(expression[i:], " \t\n")
+			isKeywordArgument := strings.HasPrefix(next, "=") && !strings.HasPrefix(next, "==")
+			skip := previous == "." || previous == "|" || previous == "is" || previous == "is not" || isKeywordArgument
+
+			checked := path
+			if strings.HasPrefix(next, "(") && len(path) > 1 {
+				// a method call, e.g. job.host_status_counts.items()
+				checked = path[:len(path)-1]
+			}
+			if !skip {
+				if err := v.checkVariable(checked, locals); err != nil {
+					return err
+				}
+			}
+
+			switch {
+			case path[0] == "is" && len(path) == 1:
+				previous = "is"
+			case path[0] == "not" && previous == "is":
+				previous = "is not"
+			default:
+				previous = "name"
+			}
+		case c >= '0' && c <= '9':
+			for i < len(expression) && (expression[i] >= '0' && expression[i] <= '9' || expression[i] == '.' || expression[i] == '_') {
+				i++
+			}
+			previous = "literal"
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		default:
+			previous = string(c)
+			i++
+		}
+	}
+	return nil
+}
+
+func (v jinjaTemplateValidator) checkVariable(path []string, locals map[string]bool) error {
+	root := path[0]
+	if locals[root] || jinjaGlobals[root] {
+		return nil
+	}
+
+	fields, ok := v.variables[root]
+	if !ok {
+		return fmt.Errorf("unknown variable %q, the variables available are: %s", root, strings.Join(sortedKeys(v.variables), ", "))
+	}
+
+	for i, attribute := range path[1:] {
+		if fields == nil {
+			return nil
+		}
+		next, ok := fields[attribute]
+		if !ok {
+			return fmt.Errorf("%q has no attribute %q, its attributes are: %s", strings.Join(path[:i+1], "."), attribute, strings.Join(sortedKeys(fields), ", "))
+		}
+		fields = next
+	}
+	return nil
+}
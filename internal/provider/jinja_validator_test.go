@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJinjaTemplateValidator(t *testing.T) {
+	cases := map[string]struct {
+		variables jinjaFields
+		template  string
+		err       string
+	}{
+		"plain text":         {variables: jobNotificationVariables, template: "Deploy finished"},
+		"default started":    {variables: jobNotificationVariables, template: "{{ job_friendly_name }} #{{ job.id }} '{{ job.name }}' {{ job.status }}: {{ url }}"},
+		"summary fields":     {variables: jobNotificationVariables, template: "{{ job.summary_fields.inventory.name }} by {{ job.summary_fields.created_by.username }}"},
+		"filters and tests":  {variables: jobNotificationVariables, template: "{{ job.name | upper | replace('a', 'b') }}{% if job.limit is not none %}{{ job.limit|default('all', true) }}{% endif %}"},
+		"method call":        {variables: jobNotificationVariables, template: "{{ job.name.upper() }}"},
+		"string literals":    {variables: jobNotificationVariables, template: `{{ "{{ not.a.variable }}" ~ 'x.y' }}`},
+		"comment":            {variables: jobNotificationVariables, template: "{# nothing.checked here #}{{ url }}"},
+		"raw":                {variables: jobNotificationVariables, template: "{% raw %}{{ anything }}{% endraw %}"},
+		"whitespace control": {variables: jobNotificationVariables, template: "{%- if job.failed -%}failed{%- else -%}ok{%- endif -%}"},
+		"for and set locals": {variables: jobNotificationVariables, template: "{% set n = job.name %}{% for k, v in job.host_status_counts.items() %}{{ n }} {{ k }}={{ v }} {{ loop.index }}{% endfor %}"},
+		"set a call":         {variables: jobNotificationVariables, template: "{% set who = job.summary_fields.created_by.username | default('awx', true) %}{% with n = job.name, p = job.playbook %}{{ n }}{{ p }}{% endwith %}{{ who }}"},
+		"approval":           {variables: approvalNotificationVariables, template: "The approval node \"{{ approval_node_name }}\" was {{ approval_status }}: {{ workflow_url }}"},
+		"unknown variable":   {variables: jobNotificationVariables, template: "{{ jbo.name }}", err: `unknown variable "jbo"`},
+		"unknown attribute":  {variables: jobNotificationVariables, template: "{{ job.nmae }}", err: `"job" has no attribute "nmae"`},
+		"unknown nested":     {variables: jobNotificationVariables, template: "{{ job.summary_fields.project.branch }}", err: `"job.summary_fields.project" has no attribute "branch"`},
+		"job in approval":    {variables: approvalNotificationVariables, template: "{{ job.name }}", err: `unknown variable "job"`},
+		"in a statement":     {variables: jobNotificationVariables, template: "{% if jobs %}{% endif %}", err: `unknown variable "jobs"`},
+		"unclosed tag":       {variables: jobNotificationVariables, template: "{{ job.name ", err: `"{{" is never closed`},
+		"unclosed block":     {variables: jobNotificationVariables, template: "{% if job.failed %}failed", err: `{% if %} block is never closed`},
+		"mismatched end":     {variables: jobNotificationVariables, template: "{% for h in job.artifacts %}{% endif %}", err: `unexpected {% endif %}`},
+		"unknown tag":        {variables: jobNotificationVariables, template: "{% include 'x' %}", err: `unknown tag {% include %}`},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := jinjaTemplateValidator{variables: c.variables}.check(c.template)
+			if c.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
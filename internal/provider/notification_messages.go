@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// notificationMessageModel is the custom message of one notification event.
+type notificationMessageModel struct {
+	Message types.String `tfsdk:"message"`
+	Body    types.String `tfsdk:"body"`
+}
+
+type notificationApprovalMessagesModel struct {
+	Approved *notificationMessageModel `tfsdk:"approved"`
+	Denied   *notificationMessageModel `tfsdk:"denied"`
+	Running  *notificationMessageModel `tfsdk:"running"`
+	TimedOut *notificationMessageModel `tfsdk:"timed_out"`
+}
+
+type notificationMessagesModel struct {
+	Started          *notificationMessageModel          `tfsdk:"started"`
+	Success          *notificationMessageModel          `tfsdk:"success"`
+	Error            *notificationMessageModel          `tfsdk:"error"`
+	WorkflowApproval *notificationApprovalMessagesModel `tfsdk:"workflow_approval"`
+}
+
+// notificationMessageAPIModel is an event's message in the API. An event without a custom
+// message is null, and AWX sends its default message.
+type notificationMessageAPIModel struct {
+	Message string  `json:"message"`
+	Body    *string `json:"body,omitempty"`
+}
+
+type notificationApprovalMessagesAPIModel struct {
+	Approved *notificationMessageAPIModel `json:"approved"`
+	Denied   *notificationMessageAPIModel `json:"denied"`
+	Running  *notificationMessageAPIModel `json:"running"`
+	TimedOut *notificationMessageAPIModel `json:"timed_out"`
+}
+
+type notificationMessagesAPIModel struct {
+	Started          *notificationMessageAPIModel          `json:"started"`
+	Success          *notificationMessageAPIModel          `json:"success"`
+	Error            *notificationMessageAPIModel          `json:"error"`
+	WorkflowApproval *notificationApprovalMessagesAPIModel `json:"workflow_approval"`
+}
+
+var notificationMessageAttrTypes = map[string]attr.Type{
+	"message": types.StringType,
+	"body":    types.StringType,
+}
+
+var notificationApprovalMessagesAttrTypes = map[string]attr.Type{
+	"approved":  types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"denied":    types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"running":   types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"timed_out": types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+}
+
+var notificationMessagesAttrTypes = map[string]attr.Type{
+	"started":           types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"success":           types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"error":             types.ObjectType{AttrTypes: notificationMessageAttrTypes},
+	"workflow_approval": types.ObjectType{AttrTypes: notificationApprovalMessagesAttrTypes},
+}
+
+func notificationMessageAttribute(description string, variables jinjaFields) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description + " Unset to use AWX's default message.",
+		Attributes: map[string]schema.Attribute{
+			"message": schema.StringAttribute{
+				Required:    true,
+				Description: "Jinja template of the message, or of the subject of an email.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					jinjaTemplateValidator{variables: variables},
+				},
+			},
+			"body": schema.StringAttribute{
+				Optional:    true,
+				Description: "Jinja template of the body, for the notification types that have one, e.g. `email` and `webhook`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					jinjaTemplateValidator{variables: variables},
+				},
+			},
+		},
+	}
+}
+
+func notificationMessagesAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: "Custom messages of the notification events. The templates are checked at plan time against the variables AWX provides: " +
+			"`job`, `job_friendly_name`, `job_metadata` and `url` for job events, " +
+			"`approval_node_name`, `approval_status`, `job_metadata` and `workflow_url` for workflow approval events.",
+		Attributes: map[string]schema.Attribute{
+			"started": notificationMessageAttribute("Message when a job starts.", jobNotificationVariables),
+			"success": notificationMessageAttribute("Message when a job succeeds.", jobNotificationVariables),
+			"error":   notificationMessageAttribute("Message when a job fails.", jobNotificationVariables),
+			"workflow_approval": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Messages of workflow approval nodes.",
+				Attributes: map[string]schema.Attribute{
+					"approved":  notificationMessageAttribute("Message when an approval is approved.", approvalNotificationVariables),
+					"denied":    notificationMessageAttribute("Message when an approval is denied.", approvalNotificationVariables),
+					"running":   notificationMessageAttribute("Message when an approval is waiting for review.", approvalNotificationVariables),
+					"timed_out": notificationMessageAttribute("Message when an approval times out.", approvalNotificationVariables),
+				},
+			},
+		},
+	}
+}
+
+func (m *notificationMessageModel) toAPI() *notificationMessageAPIModel {
+	if m == nil {
+		return nil
+	}
+	return &notificationMessageAPIModel{
+		Message: m.Message.ValueString(),
+		Body:    m.Body.ValueStringPointer(),
+	}
+}
+
+// fromAPI returns the model of an event's message, nil when the event has no custom message.
+func (m *notificationMessageAPIModel) fromAPI() *notificationMessageModel {
+	if m == nil || (m.Message == "" && (m.Body == nil || *m.Body == "")) {
+		return nil
+	}
+	model := &notificationMessageModel{
+		Message: types.StringValue(m.Message),
+		Body:    types.StringNull(),
+	}
+	if m.Body != nil && *m.Body != "" {
+		model.Body = types.StringValue(*m.Body)
+	}
+	return model
+}
+
+// notificationMessagesToAPI returns the messages of a request, nil when messages is unset.
+func notificationMessagesToAPI(ctx context.Context, messages types.Object) (*notificationMessagesAPIModel, diag.Diagnostics) {
+	if messages.IsNull() || messages.IsUnknown() {
+		return nil, nil
+	}
+
+	var model notificationMessagesModel
+	diags := messages.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiModel := &notificationMessagesAPIModel{
+		Started: model.Started.toAPI(),
+		Success: model.Success.toAPI(),
+		Error:   model.Error.toAPI(),
+	}
+	if approval := model.WorkflowApproval; approval != nil {
+		apiModel.WorkflowApproval = &notificationApprovalMessagesAPIModel{
+			Approved: approval.Approved.toAPI(),
+			Denied:   approval.Denied.toAPI(),
+			Running:  approval.Running.toAPI(),
+			TimedOut: approval.TimedOut.toAPI(),
+		}
+	}
+	return apiModel, diags
+}
+
+// notificationMessagesFromAPI returns the messages attribute of a response. Events without
+// a custom message are null, and messages or workflow_approval are only set with none when
+// they were set in prior.
+func notificationMessagesFromAPI(ctx context.Context, messages *notificationMessagesAPIModel, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorModel notificationMessagesModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(notificationMessagesAttrTypes), diags
+		}
+	}
+
+	if messages == nil {
+		messages = &notificationMessagesAPIModel{}
+	}
+
+	model := notificationMessagesModel{
+		Started: messages.Started.fromAPI(),
+		Success: messages.Success.fromAPI(),
+		Error:   messages.Error.fromAPI(),
+	}
+	if approval := messages.WorkflowApproval; approval != nil {
+		model.WorkflowApproval = &notificationApprovalMessagesModel{
+			Approved: approval.Approved.fromAPI(),
+			Denied:   approval.Denied.fromAPI(),
+			Running:  approval.Running.fromAPI(),
+			TimedOut: approval.TimedOut.fromAPI(),
+		}
+		if *model.WorkflowApproval == (notificationApprovalMessagesModel{}) && priorModel.WorkflowApproval == nil {
+			model.WorkflowApproval = nil
+		}
+	}
+
+	if model == (notificationMessagesModel{}) && prior.IsNull() {
+		return types.ObjectNull(notificationMessagesAttrTypes), diags
+	}
+
+	value, valueDiags := types.ObjectValueFrom(ctx, notificationMessagesAttrTypes, model)
+	diags.Append(valueDiags...)
+	return value, diags
+}
+
+// upgradeNotificationMessagesV0 converts the json string of messages in a version 0 state to
+// the json of the messages object. Events the json left blank have no custom message.
+func upgradeNotificationMessagesV0(messages string) (any, error) {
+	var apiModel notificationMessagesAPIModel
+	if err := json.Unmarshal([]byte(messages), &apiModel); err != nil {
+		return nil, err
+	}
+
+	event := func(message *notificationMessageAPIModel) any {
+		model := message.fromAPI()
+		if model == nil {
+			return nil
+		}
+		return map[string]any{"message": model.Message.ValueString(), "body": model.Body.ValueStringPointer()}
+	}
+
+	upgraded := map[string]any{
+		"started": event(apiModel.Started),
+		"success": event(apiModel.Success),
+		"error":   event(apiModel.Error),
+	}
+	if approval := apiModel.WorkflowApproval; approval != nil {
+		approvalMessages := map[string]any{
+			"approved":  event(approval.Approved),
+			"denied":    event(approval.Denied),
+			"running":   event(approval.Running),
+			"timed_out": event(approval.TimedOut),
+		}
+		for _, message := range approvalMessages {
+			if message != nil {
+				upgraded["workflow_approval"] = approvalMessages
+				break
+			}
+		}
+	}
+	return upgraded, nil
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maskedSecret is what AWX returns on GET in place of a secret it stores encrypted.
+const maskedSecret = "$encrypted$"
+
+type notificationFieldKind int
+
+const (
+	notificationString notificationFieldKind = iota
+	notificationInt
+	notificationBool
+	notificationList
+	notificationMap
+)
+
+// notificationField is one field of a notification type's notification_configuration.
+type notificationField struct {
+	// attribute is the field's attribute name, and its name in the API unless apiName is set.
+	attribute string
+	apiName   string
+	kind      notificationFieldKind
+	// required fields have no default in AWX. Other fields default to def, or are left out of
+	// the configuration when unset if def is nil.
+	required bool
+	def      any
+	// secret fields are masked by AWX on GET, so their value is kept from state.
+	secret      bool
+	description string
+	validators  []validator.String
+}
+
+func (f notificationField) key() string {
+	if f.apiName != "" {
+		return f.apiName
+	}
+	return f.attribute
+}
+
+// notificationTypes lists the fields of every AWX notification type, as AWX's notification
+// backends declare them.
+var notificationTypes = map[string][]notificationField{
+	"awssns": {
+		{attribute: "aws_region", kind: notificationString, def: "", description: "AWS region of the topic."},
+		{attribute: "aws_access_key_id", kind: notificationString, def: "", description: "Access key ID, leave blank to use the controller's AWS credentials."},
+		{attribute: "aws_secret_access_key", kind: notificationString, def: "", secret: true, description: "Secret access key."},
+		{attribute: "aws_session_token", kind: notificationString, def: "", secret: true, description: "Session token of temporary credentials."},
+		{attribute: "sns_topic_arn", kind: notificationString, required: true, description: "ARN of the SNS topic to publish to."},
+	},
+	"email": {
+		{attribute: "host", kind: notificationString, required: true, description: "SMTP server host."},
+		{attribute: "port", kind: notificationInt, required: true, description: "SMTP server port."},
+		{attribute: "username", kind: notificationString, def: "", description: "SMTP username."},
+		{attribute: "password", kind: notificationString, def: "", secret: true, description: "SMTP password."},
+		{attribute: "use_tls", kind: notificationBool, def: false, description: "Use STARTTLS."},
+		{attribute: "use_ssl", kind: notificationBool, def: false, description: "Use SSL."},
+		{attribute: "sender", kind: notificationString, required: true, description: "Sender email address."},
+		{attribute: "recipients", kind: notificationList, required: true, description: "Recipient email addresses."},
+		{attribute: "timeout", kind: notificationInt, def: int32(30), description: "SMTP connection timeout in seconds, between 1 and 120."},
+	},
+	"grafana": {
+		{attribute: "grafana_url", kind: notificationString, required: true, description: "Base URL of the Grafana server, annotations are posted to its API."},
+		{attribute: "grafana_key", kind: notificationString, required: true, secret: true, description: "Grafana API key."},
+		{attribute: "dashboard_id", apiName: "dashboardId", kind: notificationInt, description: "ID of the dashboard to annotate."},
+		{attribute: "panel_id", apiName: "panelId", kind: notificationInt, description: "ID of the panel to annotate."},
+		{attribute: "annotation_tags", kind: notificationList, def: []string{}, description: "Tags of the annotations."},
+		{attribute: "grafana_no_verify_ssl", kind: notificationBool, def: false, description: "Skip verifying the Grafana server's certificate."},
+		{attribute: "is_region", apiName: "isRegion", kind: notificationBool, def: true, description: "Annotate the job's run as a region, from its start to its end."},
+	},
+	"irc": {
+		{attribute: "server", kind: notificationString, required: true, description: "IRC server address."},
+		{attribute: "port", kind: notificationInt, required: true, description: "IRC server port."},
+		{attribute: "nickname", kind: notificationString, required: true, description: "IRC nickname."},
+		{attribute: "password", kind: notificationString, def: "", secret: true, description: "IRC server password."},
+		{attribute: "use_ssl", kind: notificationBool, def: false, description: "Use SSL."},
+		{attribute: "targets", kind: notificationList, required: true, description: "Channels or users to notify, e.g. `#ops`."},
+	},
+	"mattermost": {
+		{attribute: "mattermost_url", kind: notificationString, required: true, description: "Mattermost incoming webhook URL."},
+		{attribute: "mattermost_no_verify_ssl", kind: notificationBool, def: false, description: "Skip verifying the Mattermost server's certificate."},
+		{attribute: "mattermost_channel", kind: notificationString, def: "", description: "Channel to post to, instead of the webhook's."},
+		{attribute: "mattermost_username", kind: notificationString, def: "", description: "Username to post as."},
+		{attribute: "mattermost_icon_url", kind: notificationString, def: "", description: "URL of the icon to post with."},
+	},
+	"pagerduty": {
+		{attribute: "token", kind: notificationString, required: true, secret: true, description: "PagerDuty API token."},
+		{attribute: "subdomain", kind: notificationString, required: true, description: "PagerDuty subdomain."},
+		{attribute: "service_key", kind: notificationString, required: true, secret: true, description: "Integration key of the PagerDuty service."},
+		{attribute: "client_name", kind: notificationString, required: true, description: "Client name the incidents are reported by."},
+	},
+	"rocketchat": {
+		{attribute: "rocketchat_url", kind: notificationString, required: true, description: "Rocket.Chat incoming webhook URL."},
+		{attribute: "rocketchat_no_verify_ssl", kind: notificationBool, def: false, description: "Skip verifying the Rocket.Chat server's certificate."},
+		{attribute: "rocketchat_username", kind: notificationString, def: "", description: "Username to post as."},
+		{attribute: "rocketchat_icon_url", kind: notificationString, def: "", description: "URL of the icon to post with."},
+	},
+	"slack": {
+		{attribute: "channels", kind: notificationList, required: true, description: "Channels to post to, e.g. `#ops`."},
+		{attribute: "token", kind: notificationString, required: true, secret: true, description: "Slack bot token."},
+		{attribute: "hex_color", kind: notificationString, def: "", description: "Color of the notification, e.g. `#3af`."},
+	},
+	"twilio": {
+		{attribute: "account_sid", kind: notificationString, required: true, description: "Twilio account SID."},
+		{attribute: "account_token", kind: notificationString, required: true, secret: true, description: "Twilio account auth token."},
+		{attribute: "from_number", kind: notificationString, required: true, description: "Number the text messages are sent from, e.g. `+15551234567`."},
+		{attribute: "to_numbers", kind: notificationList, required: true, description: "Numbers to text."},
+	},
+	"webhook": {
+		{attribute: "url", kind: notificationString, required: true, description: "URL the notifications are sent to."},
+		{attribute: "http_method", kind: notificationString, def: "POST", description: "HTTP method, `POST` or `PUT`.", validators: []validator.String{
+			stringvalidator.OneOf("POST", "PUT"),
+		}},
+		{attribute: "headers", kind: notificationMap, def: map[string]string{}, description: "HTTP headers to send."},
+		{attribute: "username", kind: notificationString, def: "", description: "Basic authentication username."},
+		{attribute: "password", kind: notificationString, def: "", secret: true, description: "Basic authentication password."},
+		{attribute: "disable_ssl_verification", kind: notificationBool, def: false, description: "Skip verifying the server's certificate."},
+	},
+}
+
+// notificationTypeNames returns the keys of notificationTypes in a stable order, for validators and docs.
+func notificationTypeNames() []string {
+	return sortedKeys(notificationTypes)
+}
+
+// notificationConfigurationAttribute returns the nested attribute holding the typed configuration of a notification type.
+func notificationConfigurationAttribute(notificationType string) schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+	for _, f := range notificationTypes[notificationType] {
+		attributes[f.attribute] = f.schemaAttribute()
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Configuration of a `%s` notification, set when `notification_type` is `%s`.", notificationType, notificationType),
+		Attributes:  attributes,
+	}
+}
+
+func (f notificationField) schemaAttribute() schema.Attribute {
+	optional := !f.required
+	computed := f.def != nil
+	description := f.description
+	if f.secret {
+		description += " AWX never returns it, so changes made outside of terraform are not detected."
+	}
+
+	switch f.kind {
+	case notificationInt:
+		a := schema.Int32Attribute{Required: f.required, Optional: optional, Computed: computed, Sensitive: f.secret, Description: description}
+		if computed {
+			a.Default = int32default.StaticInt32(f.def.(int32))
+		}
+		return a
+	case notificationBool:
+		a := schema.BoolAttribute{Required: f.required, Optional: optional, Computed: computed, Sensitive: f.secret, Description: description}
+		if computed {
+			a.Default = booldefault.StaticBool(f.def.(bool))
+		}
+		return a
+	case notificationList:
+		a := schema.ListAttribute{Required: f.required, Optional: optional, Computed: computed, Sensitive: f.secret, Description: description, ElementType: types.StringType}
+		if computed {
+			a.Default = listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+		}
+		return a
+	case notificationMap:
+		a := schema.MapAttribute{Required: f.required, Optional: optional, Computed: computed, Sensitive: f.secret, Description: description, ElementType: types.StringType}
+		if computed {
+			a.Default = mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{}))
+		}
+		return a
+	default:
+		a := schema.StringAttribute{Required: f.required, Optional: optional, Computed: computed, Sensitive: f.secret, Description: description, Validators: f.validators}
+		if computed {
+			a.Default = stringdefault.StaticString(f.def.(string))
+		}
+		return a
+	}
+}
+
+func (f notificationField) attrType() attr.Type {
+	switch f.kind {
+	case notificationInt:
+		return types.Int32Type
+	case notificationBool:
+		return types.BoolType
+	case notificationList:
+		return types.ListType{ElemType: types.StringType}
+	case notificationMap:
+		return types.MapType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+func (f notificationField) nullValue() attr.Value {
+	switch f.kind {
+	case notificationInt:
+		return types.Int32Null()
+	case notificationBool:
+		return types.BoolNull()
+	case notificationList:
+		return types.ListNull(types.StringType)
+	case notificationMap:
+		return types.MapNull(types.StringType)
+	default:
+		return types.StringNull()
+	}
+}
+
+// notificationAttrTypes returns the attribute types of a notification type's configuration object.
+func notificationAttrTypes(notificationType string) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+	for _, f := range notificationTypes[notificationType] {
+		attrTypes[f.attribute] = f.attrType()
+	}
+	return attrTypes
+}
+
+// notificationSecretKeys returns the API names of a notification type's secret fields.
+func notificationSecretKeys(notificationType string) map[string]bool {
+	keys := map[string]bool{}
+	for _, f := range notificationTypes[notificationType] {
+		if f.secret {
+			keys[f.key()] = true
+		}
+	}
+	return keys
+}
+
+// notificationConfigurationToAPI converts a typed configuration to the notification_configuration
+// the API expects. Fields without a value are left out, for AWX to default.
+func notificationConfigurationToAPI(ctx context.Context, notificationType string, config types.Object) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body := map[string]any{}
+	attributes := config.Attributes()
+
+	for _, f := range notificationTypes[notificationType] {
+		value, ok := attributes[f.attribute]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.String:
+			body[f.key()] = v.ValueString()
+		case types.Int32:
+			body[f.key()] = v.ValueInt32()
+		case types.Bool:
+			body[f.key()] = v.ValueBool()
+		case types.List:
+			var list []string
+			diags.Append(v.ElementsAs(ctx, &list, false)...)
+			body[f.key()] = list
+		case types.Map:
+			var m map[string]string
+			diags.Append(v.ElementsAs(ctx, &m, false)...)
+			body[f.key()] = m
+		}
+	}
+
+	return body, diags
+}
+
+// notificationConfigurationFromAPI converts a notification_configuration returned by the API to
+// a typed configuration. AWX masks secrets on GET, so they are taken from prior, the configuration in state.
+func notificationConfigurationFromAPI(ctx context.Context, notificationType string, config map[string]any, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	priorAttributes := prior.Attributes()
+	attributes := map[string]attr.Value{}
+
+	for _, f := range notificationTypes[notificationType] {
+		raw := config[f.key()]
+
+		if f.secret {
+			if priorValue, ok := priorAttributes[f.attribute]; ok && !prior.IsNull() {
+				attributes[f.attribute] = priorValue
+				continue
+			}
+			// e.g. just imported, a secret that is set cannot be known
+			if raw == maskedSecret {
+				raw = nil
+			}
+		}
+
+		if raw == nil {
+			attributes[f.attribute] = f.nullValue()
+			continue
+		}
+
+		var d diag.Diagnostics
+		switch f.kind {
+		case notificationInt:
+			n, ok := raw.(float64)
+			if !ok {
+				d.AddError("Unexpected notification configuration", fmt.Sprintf("%s of a %s notification is not a number: %v.", f.key(), notificationType, raw))
+				break
+			}
+			attributes[f.attribute] = types.Int32Value(int32(n))
+		case notificationBool:
+			b, ok := raw.(bool)
+			if !ok {
+				d.AddError("Unexpected notification configuration", fmt.Sprintf("%s of a %s notification is not a boolean: %v.", f.key(), notificationType, raw))
+				break
+			}
+			attributes[f.attribute] = types.BoolValue(b)
+		case notificationList:
+			items, ok := raw.([]any)
+			if !ok {
+				d.AddError("Unexpected notification configuration", fmt.Sprintf("%s of a %s notification is not a list: %v.", f.key(), notificationType, raw))
+				break
+			}
+			list := make([]string, 0, len(items))
+			for _, item := range items {
+				list = append(list, fmt.Sprint(item))
+			}
+			attributes[f.attribute], d = types.ListValueFrom(ctx, types.StringType, list)
+		case notificationMap:
+			entries, ok := raw.(map[string]any)
+			if !ok {
+				d.AddError("Unexpected notification configuration", fmt.Sprintf("%s of a %s notification is not an object: %v.", f.key(), notificationType, raw))
+				break
+			}
+			m := make(map[string]string, len(entries))
+			for k, v := range entries {
+				m[k] = fmt.Sprint(v)
+			}
+			attributes[f.attribute], d = types.MapValueFrom(ctx, types.StringType, m)
+		default:
+			attributes[f.attribute] = types.StringValue(fmt.Sprint(raw))
+		}
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectNull(notificationAttrTypes(notificationType)), diags
+	}
+
+	object, d := types.ObjectValue(notificationAttrTypes(notificationType), attributes)
+	diags.Append(d...)
+	return object, diags
+}
@@ -6,15 +6,21 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,6 +42,28 @@ type awxProviderModel struct {
 	Token    types.String `tfsdk:"token"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+
+	TokenExchange    types.Bool   `tfsdk:"token_exchange"`
+	TokenScope       types.String `tfsdk:"token_scope"`
+	TokenApplication types.Int32  `tfsdk:"token_application"`
+
+	PageSize     types.Int32 `tfsdk:"page_size"`
+	MaxRetries   types.Int32 `tfsdk:"max_retries"`
+	RetryMaxWait types.Int32 `tfsdk:"retry_max_wait"`
+
+	MaxConcurrentRequests types.Int32   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.Int32  `tfsdk:"request_timeout"`
+
+	APIBasePath types.String `tfsdk:"api_base_path"`
+	ConfigFile  types.String `tfsdk:"config_file"`
+	Profile     types.String `tfsdk:"profile"`
 }
 
 func (p *awxProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,24 +73,119 @@ func (p *awxProvider) Metadata(ctx context.Context, req provider.MetadataRequest
 
 func (p *awxProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "**Warning**: All v0 releases are considered alpha and subject to breaking changes at any time.",
+		Description: "**Warning**: All v0 releases are considered alpha and subject to breaking changes at any time.\n\n" +
+			"Connection settings that are not set in the provider block are read from, in order of precedence, the `CONTROLLER_*` environment variables, " +
+			"the `TOWER_*` environment variables and then an awx CLI config file. Credentials are never mixed between these sources.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				Description: "URL for AWX (i.e. https://tower.example.com)",
-				Required:    true,
+				Description: "URL for AWX (i.e. https://tower.example.com). Can also be set with the `CONTROLLER_HOST` or `TOWER_HOST` environment variables, or `host` in an awx CLI config file.",
+				Optional:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "AWX access token (instead of username/password)",
+				Description: "AWX access token (instead of username/password). Can also be set with the `CONTROLLER_OAUTH_TOKEN` or `TOWER_OAUTH_TOKEN` environment variables, or `oauth_token` in an awx CLI config file.",
 				Optional:    true,
+				Sensitive:   true,
 			},
 			"username": schema.StringAttribute{
-				Description: "AWX username (instead of token)",
+				Description: "AWX username (instead of token). Can also be set with the `CONTROLLER_USERNAME` or `TOWER_USERNAME` environment variables, or `username` in an awx CLI config file.",
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "AWX password (instead of token)",
+				Description: "AWX password (instead of token). Can also be set with the `CONTROLLER_PASSWORD` or `TOWER_PASSWORD` environment variables, or `password` in an awx CLI config file.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_exchange": schema.BoolAttribute{
+				Description: "Exchange `username` and `password` for a short-lived OAuth2 token when the provider is configured, and use that token for the rest of the run. " +
+					"The token is revoked when the provider exits. Requires username/password authentication. Defaults to `false`.",
+				Optional: true,
+			},
+			"token_scope": schema.StringAttribute{
+				Description: "Scope of the token created by `token_exchange`, `read` or `write`. Defaults to `write`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("read", "write"),
+				},
+			},
+			"token_application": schema.Int32Attribute{
+				Description: "ID of an OAuth2 application to create an application token for when `token_exchange` is set. When not set, a personal access token is created.",
+				Optional:    true,
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path to an awx / tower CLI config file (INI format, e.g. `~/.tower_cli.cfg`) to read `host`, `oauth_token`, `username`, `password` and `verify_ssl` from. When not set, the first of `./tower_cli.cfg`, `~/.tower_cli.cfg` and `/etc/tower/tower_cli.cfg` that exists is used.",
+				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Section of the config file to read. Defaults to `general`.",
+				Optional:    true,
+			},
+			"page_size": schema.Int32Attribute{
+				Description: "Number of results to request per page when reading AWX list endpoints. Every page is followed, so this only tunes the number of requests made. AWX caps this value at its `MAX_PAGE_SIZE` setting. Defaults to `200`.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int32Attribute{
+				Description: "Number of times a request is retried after a transient failure. GET, PUT and DELETE requests are retried on 5xx responses and network errors, and any request is retried on a 429 response. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int32Attribute{
+				Description: "Maximum number of seconds to wait between retries. Waits grow exponentially with jitter, or follow the `Retry-After` header AWX sends, up to this value. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Description: "Maximum number of requests sent to AWX at the same time, shared by every resource and data source using this provider. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests sent to AWX per second, shared by every resource and data source using this provider. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificate(s) to trust in addition to the system pool when verifying the AWX server certificate. Can also be set with the `TOWER_CA_CERT_PEM` environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file of PEM encoded CA certificate(s) to trust in addition to the system pool when verifying the AWX server certificate. Can also be set with the `TOWER_CA_CERT_FILE` environment variable.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate to present to AWX for mutual TLS. Requires `client_key`. Can also be set with the `TOWER_CLIENT_CERT` environment variable.",
 				Optional:    true,
 			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key for `client_cert`. Can also be set with the `TOWER_CLIENT_KEY` environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the AWX server certificate. Only use this for testing. Can also be set with the `TOWER_INSECURE_SKIP_VERIFY` environment variable. Defaults to `false`.",
+				Optional:    true,
+			},
+			"api_base_path": schema.StringAttribute{
+				Description: "Path of the controller API on `endpoint`, e.g. `/api/v2/` for AWX or `/api/controller/v2/` for Ansible Automation Platform 2.5+ behind the platform gateway. When not set, the path is discovered from the `/api/` root document. Can also be set with the `TOWER_API_BASE_PATH` environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int32Attribute{
+				Description: "Number of seconds to wait for a single AWX API request to complete. Can also be set with the `TOWER_REQUEST_TIMEOUT` environment variable. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -81,6 +204,18 @@ func (p *awxProvider) ConfigValidators(ctx context.Context) []provider.ConfigVal
 			path.MatchRoot("username"),
 			path.MatchRoot("password"),
 		),
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("token_exchange"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_pem"),
+			path.MatchRoot("ca_cert_file"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert"),
+			path.MatchRoot("client_key"),
+		),
 	}
 }
 
@@ -97,52 +232,96 @@ func (p *awxProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	if !data.Endpoint.IsNull() {
-		endpoint = data.Endpoint.ValueString()
-	} else {
-		endpoint = os.Getenv("TOWER_HOST")
+	envController, err := settingsFromEnv("CONTROLLER")
+	if err != nil {
+		resp.Diagnostics.AddError("Provider Configuration Error", err.Error())
+		return
 	}
 
-	if endpoint == "" {
-		resp.Diagnostics.AddError(
-			"Missing API Endpoint Configuration",
-			"While configuring the provider, the API endpoint hostname was not found in "+
-				"the TOWER_HOST environment variable or provider "+
-				"configuration block endpoint attribute.",
-		)
-		// Not returning early allows the logic to collect all errors.
+	envTower, err := settingsFromEnv("TOWER")
+	if err != nil {
+		resp.Diagnostics.AddError("Provider Configuration Error", err.Error())
+		return
 	}
 
-	envToken, tokenExists := os.LookupEnv("TOWER_OAUTH_TOKEN")
-	envUsername, userExists := os.LookupEnv("TOWER_USERNAME")
-	envPassword, passwordExists := os.LookupEnv("TOWER_PASSWORD")
+	var fileSettings connectionSettings
+
+	profile := defaultCLIConfigProfile
+	if !data.Profile.IsNull() {
+		profile = data.Profile.ValueString()
+	}
 
-	// Get token if password/username not set
-	if data.Token.IsNull() && data.Username.IsNull() && data.Password.IsNull() && tokenExists {
-		token = envToken
+	configFile := data.ConfigFile.ValueString()
+	if configFile == "" {
+		configFile = findCLIConfigFile()
 	}
 
-	if data.Token.IsNull() && data.Username.IsNull() && data.Password.IsNull() && !tokenExists && userExists && passwordExists {
-		username = envUsername
-		password = envPassword
+	if configFile != "" {
+		settings, found, err := readCLIConfigFile(configFile, profile)
+		if err != nil {
+			resp.Diagnostics.AddError("Provider Configuration Error", err.Error())
+			return
+		}
+		// a default config file without the profile is ignored, but one the user asked for is an error
+		if !found && (!data.ConfigFile.IsNull() || !data.Profile.IsNull()) {
+			resp.Diagnostics.AddError(
+				"Provider Configuration Error",
+				fmt.Sprintf("Profile [%s] was not found in config file %s.", profile, configFile))
+			return
+		}
+		fileSettings = settings
+	} else if !data.Profile.IsNull() {
+		resp.Diagnostics.AddError(
+			"Provider Configuration Error",
+			fmt.Sprintf("Profile %s was configured, but no config file was found in %s.", profile, strings.Join(defaultCLIConfigFiles(), ", ")))
+		return
 	}
 
-	if !data.Token.IsNull() {
-		token = data.Token.ValueString()
+	// Settings not set in the provider block are taken from, in order of precedence, the CONTROLLER_*
+	// environment variables, the TOWER_* environment variables and then the awx CLI config file.
+	sources := []connectionSettings{envController, envTower, fileSettings}
+
+	endpoint = data.Endpoint.ValueString()
+
+	for _, source := range sources {
+		if endpoint == "" {
+			endpoint = source.Host
+		}
 	}
 
-	if !data.Username.IsNull() {
-		username = data.Username.ValueString()
+	if endpoint == "" {
+		resp.Diagnostics.AddError(
+			"Missing API Endpoint Configuration",
+			"While configuring the provider, the API endpoint hostname was not found in "+
+				"the provider configuration block endpoint attribute, the CONTROLLER_HOST or "+
+				"TOWER_HOST environment variables or an awx CLI config file.",
+		)
+		// Not returning early allows the logic to collect all errors.
 	}
 
-	if data.Password.IsNull() {
-		password = data.Password.ValueString()
+	token = data.Token.ValueString()
+	username = data.Username.ValueString()
+	password = data.Password.ValueString()
+
+	// Credentials are never mixed between sources, the first source that sets any of them provides
+	// all of them. A token wins over a username/password found in the same source.
+	if token == "" && username == "" && password == "" {
+		for _, source := range sources {
+			if source.hasCredentials() {
+				token = source.Token
+				if token == "" {
+					username = source.Username
+					password = source.Password
+				}
+				break
+			}
+		}
 	}
 
 	if (token != "" && (username != "" || password != "")) || (token == "" && (username == "" || password == "")) {
 		resp.Diagnostics.AddError(
 			"Provider Configuration Error",
-			"Specify a token (TOWER_OAUTH_TOKEN) OR username/password (TOWER_USERNAME/TOWER_PASSWORD).")
+			"Specify a token (CONTROLLER_OAUTH_TOKEN/TOWER_OAUTH_TOKEN) OR username/password (CONTROLLER_USERNAME/CONTROLLER_PASSWORD or TOWER_USERNAME/TOWER_PASSWORD).")
 		return
 	}
 
@@ -154,18 +333,132 @@ func (p *awxProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		auth = "Basic" + " " + encodedAuth
 	}
 
-	httpclient := &http.Client{
-		Timeout: 30 * time.Second,
+	tlsSettings := TLSSettings{
+		CACertPEM:     configOrEnv(data.CACertPEM, "TOWER_CA_CERT_PEM"),
+		CACertFile:    configOrEnv(data.CACertFile, "TOWER_CA_CERT_FILE"),
+		ClientCertPEM: configOrEnv(data.ClientCert, "TOWER_CLIENT_CERT"),
+		ClientKeyPEM:  configOrEnv(data.ClientKey, "TOWER_CLIENT_KEY"),
+	}
+
+	// a ca_cert_pem in the provider block wins over a file named in the environment and vice versa
+	if !data.CACertPEM.IsNull() {
+		tlsSettings.CACertFile = ""
+	} else if !data.CACertFile.IsNull() {
+		tlsSettings.CACertPEM = ""
 	}
 
+	if !data.InsecureSkipVerify.IsNull() {
+		tlsSettings.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if envInsecure, ok := os.LookupEnv("TOWER_INSECURE_SKIP_VERIFY"); ok {
+		insecure, err := strconv.ParseBool(envInsecure)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Provider Configuration Error",
+				fmt.Sprintf("Unable to parse TOWER_INSECURE_SKIP_VERIFY value %q as a bool.", envInsecure))
+			return
+		}
+		tlsSettings.InsecureSkipVerify = insecure
+	} else {
+		for _, source := range sources {
+			if source.VerifySSL != nil {
+				tlsSettings.InsecureSkipVerify = !*source.VerifySSL
+				break
+			}
+		}
+	}
+
+	tlsConfig, err := tlsSettings.tlsConfig()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Provider TLS Configuration Error",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	requestTimeout := defaultRequestTimeout
+
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout = time.Duration(data.RequestTimeout.ValueInt32()) * time.Second
+	} else if envTimeout, ok := os.LookupEnv("TOWER_REQUEST_TIMEOUT"); ok {
+		seconds, err := strconv.Atoi(envTimeout)
+		if err != nil || seconds < 1 {
+			resp.Diagnostics.AddError(
+				"Provider Configuration Error",
+				fmt.Sprintf("Unable to parse TOWER_REQUEST_TIMEOUT value %q as a positive number of seconds.", envTimeout))
+			return
+		}
+		requestTimeout = time.Duration(seconds) * time.Second
+	}
+
+	httpclient := newHTTPClient(tlsConfig, requestTimeout)
+
 	client := new(AwxClient)
 
 	client.client = httpclient
-	client.endpoint = endpoint
+	client.endpoint = strings.TrimSuffix(endpoint, "/")
 	client.auth = auth
+	client.pageSize = defaultPageSize
 
-	url := "/api/v2/me/"
-	_, _, err := client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	if !data.PageSize.IsNull() {
+		client.pageSize = int(data.PageSize.ValueInt32())
+	}
+
+	client.retry = defaultRetryPolicy()
+
+	if !data.MaxRetries.IsNull() {
+		client.retry.MaxRetries = int(data.MaxRetries.ValueInt32())
+	}
+
+	if !data.RetryMaxWait.IsNull() {
+		client.retry.MaxWait = time.Duration(data.RetryMaxWait.ValueInt32()) * time.Second
+	}
+
+	client.limiter = newRequestLimiter(int(data.MaxConcurrentRequests.ValueInt32()), data.RequestsPerSecond.ValueFloat64())
+
+	if apiBasePath := configOrEnv(data.APIBasePath, "TOWER_API_BASE_PATH"); apiBasePath != "" {
+		client.apiBasePath = normalizeAPIBasePath(apiBasePath)
+	} else {
+		client.apiBasePath, err = client.discoverAPIBasePath(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to discover the AWX API base path",
+				fmt.Sprintf("Set api_base_path in the provider configuration to skip discovery. Error was: %s.", err.Error()))
+			return
+		}
+	}
+
+	if data.TokenExchange.ValueBool() {
+		if token != "" {
+			resp.Diagnostics.AddError(
+				"Provider Configuration Error",
+				"token_exchange requires username/password authentication, but a token was configured.")
+			return
+		}
+
+		scope := defaultTokenScope
+		if !data.TokenScope.IsNull() {
+			scope = data.TokenScope.ValueString()
+		}
+
+		var application *int
+		if !data.TokenApplication.IsNull() {
+			applicationId := int(data.TokenApplication.ValueInt32())
+			application = &applicationId
+		}
+
+		exchanged, err := client.exchangeToken(ctx, scope, application)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to exchange the username and password for a token",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		client.auth = "Bearer" + " " + exchanged
+	}
+
+	url := client.APIPath("me/")
+	_, _, err = client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"tower authentication failure",
@@ -173,15 +466,45 @@ func (p *awxProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	client.version, err = client.readVersion(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read the AWX version",
+			fmt.Sprintf("Attributes that need a newer AWX will not be checked at plan time. Error was: %s.", err.Error()))
+	}
+
+	client.endpoints, err = client.readEndpoints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read the AWX API root",
+			fmt.Sprintf("Resources that need an endpoint only newer AWX versions have will not be checked at plan time. Error was: %s.", err.Error()))
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// configOrEnv returns the attribute's value when it is set in the provider block,
+// otherwise the value of the named environment variable.
+func configOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
 func (p *awxProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewConstructedInventoryResource,
+		NewCredentialInputSourceResource,
 		NewCredentialResource,
 		NewCredentialTypeResource,
+		NewExecutionEnvironmentResource,
+		NewGroupChildrenResource,
+		NewGroupHostsResource,
+		NewGroupResource,
 		NewHostResource,
+		NewInstanceGroupResource,
 		NewInventoryResource,
 		NewInventorySourceResource,
 		NewJobTemplateCredentialResource,
@@ -193,10 +516,19 @@ func (p *awxProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewJobTemplateResource,
 		NewJobTemplateSurveyResource,
 		NewLabelsResource,
+		NewNotificationAttachmentResource,
 		NewNotificationTemplatesResource,
+		NewOrganizationAdminResource,
+		NewOrganizationMemberResource,
 		NewOrganizationResource,
 		NewProjectResource,
+		NewRoleAssignmentResource,
+		NewRoleDefinitionResource,
+		NewRoleTeamAssignmentResource,
+		NewRoleUserAssignmentResource,
 		NewScheduleResource,
+		NewTeamMemberResource,
+		NewTeamResource,
 		NewUserResource,
 		NewWorkflowJobTemplatesResource,
 		NewWorkflowJobTemplatesJobNodeResource,
@@ -213,6 +545,7 @@ func (p *awxProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewCredentialDataSource,
 		NewCredentialTypeDataSource,
 		NewExecutionEnvironmentDataSource,
+		NewGroupDataSource,
 		NewHostDataSource,
 		NewInventoryDataSource,
 		NewInventorySourceDataSource,
@@ -220,7 +553,9 @@ func (p *awxProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewJobTemplateDataSource,
 		NewOrganizationDataSource,
 		NewProjectDataSource,
+		NewRoleDataSource,
 		NewScheduleDataSource,
+		NewTeamDataSource,
 		NewUserDataSource,
 	}
 }
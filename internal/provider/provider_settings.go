@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The section of a tower_cli.cfg style file read when no profile is configured.
+const defaultCLIConfigProfile = "general"
+
+// connectionSettings is one source of connection settings: the environment or an awx CLI config file.
+type connectionSettings struct {
+	Host      string
+	Token     string
+	Username  string
+	Password  string
+	VerifySSL *bool
+}
+
+func (s connectionSettings) hasCredentials() bool {
+	return s.Token != "" || s.Username != "" || s.Password != ""
+}
+
+// settingsFromEnv reads the <prefix>_HOST, <prefix>_OAUTH_TOKEN, <prefix>_USERNAME, <prefix>_PASSWORD
+// and <prefix>_VERIFY_SSL environment variables, e.g. for the CONTROLLER or TOWER prefix.
+func settingsFromEnv(prefix string) (connectionSettings, error) {
+	settings := connectionSettings{
+		Host:     os.Getenv(prefix + "_HOST"),
+		Token:    os.Getenv(prefix + "_OAUTH_TOKEN"),
+		Username: os.Getenv(prefix + "_USERNAME"),
+		Password: os.Getenv(prefix + "_PASSWORD"),
+	}
+
+	if value, ok := os.LookupEnv(prefix + "_VERIFY_SSL"); ok {
+		verify, err := strconv.ParseBool(value)
+		if err != nil {
+			return settings, fmt.Errorf("unable to parse %s_VERIFY_SSL value %q as a bool", prefix, value)
+		}
+		settings.VerifySSL = &verify
+	}
+
+	return settings, nil
+}
+
+// Default locations of an awx / tower CLI config file, most specific first.
+func defaultCLIConfigFiles() []string {
+	files := []string{"tower_cli.cfg"}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		files = append(files, filepath.Join(home, ".tower_cli.cfg"))
+	}
+
+	return append(files, "/etc/tower/tower_cli.cfg")
+}
+
+// findCLIConfigFile returns the first default CLI config file that exists, or "" if there is none.
+func findCLIConfigFile() string {
+	for _, file := range defaultCLIConfigFiles() {
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+	return ""
+}
+
+// readCLIConfigFile reads the profile section of a tower_cli.cfg style INI file. Keys may be given
+// bare (host, username, password, oauth_token, verify_ssl) or with a controller_ or tower_ prefix.
+// found reports whether the file has a section for profile at all.
+func readCLIConfigFile(path, profile string) (settings connectionSettings, found bool, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return settings, false, fmt.Errorf("unable to open config file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(file)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile {
+				found = true
+			}
+			continue
+		}
+
+		if section != profile {
+			continue
+		}
+
+		// the separator is whichever of "=" or ":" comes first, values may contain either
+		separator := strings.IndexAny(line, "=:")
+		if separator < 0 {
+			return settings, found, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+
+		key, value := line[:separator], line[separator+1:]
+		key = strings.TrimSpace(strings.ToLower(key))
+		key = strings.TrimPrefix(strings.TrimPrefix(key, "controller_"), "tower_")
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		switch key {
+		case "host":
+			settings.Host = value
+		case "oauth_token":
+			settings.Token = value
+		case "username":
+			settings.Username = value
+		case "password":
+			settings.Password = value
+		case "verify_ssl":
+			verify, err := strconv.ParseBool(value)
+			if err != nil {
+				return settings, found, fmt.Errorf("%s:%d: unable to parse verify_ssl value %q as a bool", path, lineNumber, value)
+			}
+			settings.VerifySSL = &verify
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return settings, found, fmt.Errorf("unable to read config file %s: %v", path, err)
+	}
+
+	return settings, found, nil
+}
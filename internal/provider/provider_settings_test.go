@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadCLIConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tower_cli.cfg")
+	config := `# a comment
+[general]
+host = https://awx.example.com
+username: admin
+password: a=b:c
+
+[other]
+controller_oauth_token = "x=y"
+tower_verify_ssl = false
+`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, found, err := readCLIConfigFile(path, "general")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("expected the general section to be found")
+	}
+	if settings.Host != "https://awx.example.com" || settings.Username != "admin" || settings.Password != "a=b:c" {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+
+	settings, found, err = readCLIConfigFile(path, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || settings.Token != "x=y" || settings.VerifySSL == nil || *settings.VerifySSL {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+
+	if _, found, err = readCLIConfigFile(path, "missing"); err != nil || found {
+		t.Errorf("expected no missing section and no error, got %t and %v", found, err)
+	}
+}
+
+func TestReadCLIConfigFileInvalidLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tower_cli.cfg")
+	if err := os.WriteFile(path, []byte("[general]\nhost\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := readCLIConfigFile(path, "general"); err == nil {
+		t.Error("expected an error for a line without a separator")
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"awx": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testProviderConfig returns a provider block that points the provider at server.
+func testProviderConfig(server *awxmock.Server) string {
+	return fmt.Sprintf(`
+provider "awx" {
+  endpoint = %q
+  token    = "test"
+}
+`, server.URL)
+}
+
+// testCheckRelatedCount checks how many objects are associated to the related collection of
+// the object whose id is stored in the idAttribute of resourceName, e.g. job_templates/N/labels/.
+func testCheckRelatedCount(server *awxmock.Server, resourceName, idAttribute, collection, related string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.Attributes[idAttribute])
+		if err != nil {
+			return fmt.Errorf("unable to convert %s.%s to an int: %v", resourceName, idAttribute, err)
+		}
+
+		if got := len(server.Related(collection, id, related)); got != count {
+			return fmt.Errorf("expected %d objects associated to %s/%d/%s, got %d", count, collection, id, related, got)
+		}
+		return nil
+	}
+}
+
+// testCheckWebhookKey checks resourceName's webhook_key is the key the server holds for the
+// template, and that it differs from *previous only when rotated is set. It then records the key in *previous.
+func testCheckWebhookKey(server *awxmock.Server, resourceName, collection string, previous *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to convert %s.id to an int: %v", resourceName, err)
+		}
+
+		key := rs.Primary.Attributes["webhook_key"]
+		if want := server.WebhookKey(collection, id); key != want {
+			return fmt.Errorf("expected webhook_key %q, got %q", want, key)
+		}
+		if rotated && key == *previous {
+			return fmt.Errorf("expected webhook_key to be rotated, it is still %q", key)
+		}
+		if !rotated && key != *previous {
+			return fmt.Errorf("expected webhook_key %q to be kept, got %q", *previous, key)
+		}
+		*previous = key
+		return nil
+	}
+}
+
+// testImportStateIdFromAttribute imports resourceName by the value of one of its attributes,
+// for resources that are imported by the id of the object they are attached to.
+func testImportStateIdFromAttribute(resourceName, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes[attribute], nil
+	}
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ConstructedInventoryResource{}
+var _ resource.ResourceWithImportState = &ConstructedInventoryResource{}
+var _ resource.ResourceWithModifyPlan = &ConstructedInventoryResource{}
+
+func NewConstructedInventoryResource() resource.Resource {
+	return &ConstructedInventoryResource{}
+}
+
+type ConstructedInventoryResource struct {
+	client *AwxClient
+}
+
+func (r *ConstructedInventoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_constructed_inventory"
+}
+
+func (r *ConstructedInventoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an AWX constructed inventory, an inventory of kind `constructed` whose hosts and groups are built from its input inventories " +
+			"by the `constructed` inventory plugin. The controller creates the inventory source that runs the plugin, its `source_vars` and `limit` are managed here. " +
+			"Requires AWX 22 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Inventory ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Inventory name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Inventory description.",
+				Optional:    true,
+			},
+			"organization": schema.Int32Attribute{
+				Description: "Organization ID for the inventory to live in.",
+				Required:    true,
+			},
+			"variables": schema.StringAttribute{
+				CustomType:  yamlType{},
+				Description: "Inventory variables, as YAML or JSON.",
+				Optional:    true,
+			},
+			"input_inventories": schema.ListAttribute{
+				Description: "IDs of the inventories the hosts and groups are constructed from. The order matters, " +
+					"when inventories have the same host or group, the variables of the later inventory win.",
+				Required:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"source_vars": schema.StringAttribute{
+				CustomType: yamlType{},
+				Description: "The `constructed` inventory plugin's configuration, as YAML or JSON, e.g. its `groups`, `keyed_groups` and `compose` options. " +
+					"Documents that decode to the same value are considered equal.",
+				Optional: true,
+			},
+			"limit": schema.StringAttribute{
+				Description: "Restrict the hosts taken from the input inventories with a host pattern, e.g. `webservers:&production`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ConstructedInventoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+// ModifyPlan rejects constructed inventories on a controller that predates them.
+func (r *ConstructedInventoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.checkEndpoint("constructed_inventories", "constructed inventories were introduced in AWX 22.", &resp.Diagnostics)
+}
+
+func (r *ConstructedInventoryResource) requestBody(data ConstructedInventoryModel) ConstructedInventoryAPIModel {
+	return ConstructedInventoryAPIModel{
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueString(),
+		Organization: int(data.Organization.ValueInt32()),
+		Variables:    data.Variables.ValueString(),
+		SourceVars:   data.SourceVars.ValueString(),
+		Limit:        data.Limit.ValueString(),
+	}
+}
+
+// syncInputInventories makes the inventory's input inventories the ids, in order. The input
+// inventories are kept in the order they were associated, so from the first one out of place
+// on, the current ones are disassociated and the planned ones associated again.
+func (r *ConstructedInventoryResource) syncInputInventories(ctx context.Context, id int, ids []int, diags *diag.Diagnostics) {
+	url := r.client.APIPath("inventories/%d/input_inventories/", id)
+
+	currentIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	if err != nil {
+		diags.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	keep := 0
+	for keep < len(currentIds) && keep < len(ids) && currentIds[keep] == ids[keep] {
+		keep++
+	}
+
+	for _, v := range currentIds[keep:] {
+		bodyData := ChildDissasocBody{Id: v, Disassociate: true}
+
+		_, _, err := r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
+		if err != nil {
+			diags.AddError("Failed to disassociate input inventory.", err.Error())
+			return
+		}
+	}
+
+	for _, v := range ids[keep:] {
+		bodyData := ChildResult{Id: v}
+
+		_, _, err := r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
+		if err != nil {
+			diags.AddError("Failed to associate input inventory.", err.Error())
+			return
+		}
+	}
+}
+
+func (r *ConstructedInventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConstructedInventoryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var inputInventories []int
+	resp.Diagnostics.Append(data.InputInventories.ElementsAs(ctx, &inputInventories, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.client.APIPath("constructed_inventories/")
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, r.requestBody(data), []int{201})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
+	// save the inventory before its input inventories, so it is not orphaned if they fail
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.Id)...)
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	r.syncInputInventories(ctx, id, inputInventories, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConstructedInventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConstructedInventoryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("constructed_inventories/%d/", id)
+	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var responseData ConstructedInventoryAPIModel
+
+	err = json.Unmarshal(body, &responseData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return
+	}
+
+	inputInventories, _, err := r.client.ListChildIds(ctx, r.client.APIPath("inventories/%d/input_inventories/", id), []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	data.Name = types.StringValue(responseData.Name)
+	data.Organization = types.Int32Value(int32(responseData.Organization))
+
+	if !(data.Description.IsNull() && responseData.Description == "") {
+		data.Description = types.StringValue(responseData.Description)
+	}
+	if !(data.Variables.IsNull() && responseData.Variables == "") {
+		data.Variables = newYAMLValue(responseData.Variables)
+	}
+	if !(data.SourceVars.IsNull() && responseData.SourceVars == "") {
+		data.SourceVars = newYAMLValue(responseData.SourceVars)
+	}
+	if !(data.Limit.IsNull() && responseData.Limit == "") {
+		data.Limit = types.StringValue(responseData.Limit)
+	}
+
+	var diags diag.Diagnostics
+	data.InputInventories, diags = types.ListValueFrom(ctx, types.Int32Type, inputInventories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConstructedInventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConstructedInventoryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	var inputInventories []int
+	resp.Diagnostics.Append(data.InputInventories.ElementsAs(ctx, &inputInventories, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.client.APIPath("constructed_inventories/%d/", id)
+	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, r.requestBody(data), []int{200})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	r.syncInputInventories(ctx, id, inputInventories, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConstructedInventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConstructedInventoryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+
+	url := r.client.APIPath("constructed_inventories/%d/", id)
+	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API delete request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *ConstructedInventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitConstructedInventoryResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var inventoryId int
+
+	config := func(inputInventories, limit string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "input" {
+  count        = 3
+  name         = "input-${count.index}"
+  organization = awx_organization.test.id
+}
+
+resource "awx_constructed_inventory" "test" {
+  name              = "constructed"
+  description       = "test constructed inventory"
+  organization      = awx_organization.test.id
+  input_inventories = ` + inputInventories + `
+  limit             = "` + limit + `"
+  source_vars       = <<-EOT
+    plugin: constructed
+    strict: true
+    groups:
+      shutdown: resolved_state == "shutdown"
+  EOT
+}
+`
+	}
+
+	// testCheckInputInventories checks the input inventories are associated in the order of the
+	// awx_inventory.input indexes.
+	testCheckInputInventories := func(indexes ...int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			var expected []int
+			for _, index := range indexes {
+				id, err := strconv.Atoi(s.RootModule().Resources[fmt.Sprintf("awx_inventory.input.%d", index)].Primary.ID)
+				if err != nil {
+					return err
+				}
+				expected = append(expected, id)
+			}
+
+			if got := server.Related("inventories", inventoryId, "input_inventories"); fmt.Sprint(got) != fmt.Sprint(expected) {
+				return fmt.Errorf("expected input inventories %v, got %v", expected, got)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("[awx_inventory.input[0].id, awx_inventory.input[1].id]", "shutdown"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) (err error) {
+						inventoryId, err = strconv.Atoi(s.RootModule().Resources["awx_constructed_inventory.test"].Primary.ID)
+						return err
+					},
+					resource.TestCheckResourceAttr("awx_constructed_inventory.test", "name", "constructed"),
+					resource.TestCheckResourceAttr("awx_constructed_inventory.test", "input_inventories.#", "2"),
+					resource.TestCheckResourceAttrPair("awx_constructed_inventory.test", "input_inventories.0", "awx_inventory.input.0", "id"),
+					resource.TestCheckResourceAttr("awx_constructed_inventory.test", "limit", "shutdown"),
+					resource.TestMatchResourceAttr("awx_constructed_inventory.test", "source_vars", regexp.MustCompile(`plugin: constructed`)),
+					testCheckInputInventories(0, 1),
+				),
+			},
+			{
+				ResourceName:      "awx_constructed_inventory.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the same plugin config stored as json is not a change
+				PreConfig: func() {
+					server.Update("inventories", inventoryId, awxmock.Object{
+						"source_vars": `{"strict": true, "plugin": "constructed", "groups": {"shutdown": "resolved_state == \"shutdown\""}}`,
+					})
+				},
+				Config:   config("[awx_inventory.input[0].id, awx_inventory.input[1].id]", "shutdown"),
+				PlanOnly: true,
+			},
+			{
+				// reordering the input inventories is a change
+				Config: config("[awx_inventory.input[1].id, awx_inventory.input[0].id, awx_inventory.input[2].id]", "shutdown"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_constructed_inventory.test", "input_inventories.#", "3"),
+					resource.TestCheckResourceAttrPair("awx_constructed_inventory.test", "input_inventories.0", "awx_inventory.input.1", "id"),
+					testCheckInputInventories(1, 0, 2),
+				),
+			},
+			{
+				Config: config("[awx_inventory.input[1].id, awx_inventory.input[2].id]", "all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_constructed_inventory.test", "limit", "all"),
+					testCheckInputInventories(1, 2),
+				),
+			},
+			{
+				// an input inventory associated outside of terraform is drift
+				PreConfig: func() {
+					server.Associate("inventories", inventoryId, "input_inventories", 1)
+				},
+				Config:             config("[awx_inventory.input[1].id, awx_inventory.input[2].id]", "all"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitConstructedInventoryResourceLegacyController(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+	server.Version = "21.14.0"
+	server.Endpoints = []string{"inventories", "organizations"}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_constructed_inventory" "test" {
+  name              = "constructed"
+  organization      = 1
+  input_inventories = [2]
+}
+`,
+				ExpectError: regexp.MustCompile(`does not advertise /constructed_inventories/`),
+			},
+		},
+	})
+}
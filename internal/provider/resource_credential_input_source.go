@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CredentialInputSourceResource{}
+var _ resource.ResourceWithImportState = &CredentialInputSourceResource{}
+
+func NewCredentialInputSourceResource() resource.Resource {
+	return &CredentialInputSourceResource{}
+}
+
+type CredentialInputSourceResource struct {
+	client *AwxClient
+}
+
+func (r *CredentialInputSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_input_source"
+}
+
+func (r *CredentialInputSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manage an AWX credential input source, which sources one input field of a credential from an external secret store, e.g. HashiCorp Vault or CyberArk.
+The controller looks the secret up with the source credential, a credential of a credential plugin type, whenever the target credential is used, so the secret never passes through terraform.
+Leave the field out of the target credential's ` + "`inputs`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Credential input source ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Credential input source description.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"target_credential": schema.Int32Attribute{
+				Description: "ID of the credential whose input field is sourced.",
+				Required:    true,
+			},
+			"input_field_name": schema.StringAttribute{
+				Description: "The input field of the target credential to source, one of the inputs of its credential type, e.g. `password` or `ssh_key_data`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source_credential": schema.Int32Attribute{
+				Description: "ID of the credential, of a credential plugin type, that looks the secret up.",
+				Required:    true,
+			},
+			"metadata": schema.StringAttribute{
+				Description: "The lookup's metadata as a JSON object, the inputs of the source credential type's metadata, e.g. the secret path and key. " +
+					"Use `jsonencode()` to build it.",
+				CustomType: jsonType{},
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("{}"),
+			},
+		},
+	}
+}
+
+func (r *CredentialInputSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+func (r *CredentialInputSourceResource) requestBody(data CredentialInputSourceModel) (bodyData CredentialInputSourceAPIModel, err error) {
+	bodyData = CredentialInputSourceAPIModel{
+		Description:      data.Description.ValueString(),
+		TargetCredential: int(data.TargetCredential.ValueInt32()),
+		InputFieldName:   data.InputFieldName.ValueString(),
+		SourceCredential: int(data.SourceCredential.ValueInt32()),
+	}
+	err = json.Unmarshal([]byte(data.Metadata.ValueString()), &bodyData.Metadata)
+	return
+}
+
+func (r *CredentialInputSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialInputSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bodyData, err := r.requestBody(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal map to json",
+			fmt.Sprintf("Unable to process metadata: %+v. ", data.Metadata))
+		return
+	}
+
+	url := r.client.APIPath("credential_input_sources/")
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialInputSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialInputSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("credential_input_sources/%d/", id)
+	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var responseData CredentialInputSourceAPIModel
+
+	err = json.Unmarshal(body, &responseData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return
+	}
+
+	if responseData.Metadata == nil {
+		responseData.Metadata = map[string]any{}
+	}
+	// a metadata document only formatted differently is semantically equal and keeps the configured value
+	metadata, err := json.Marshal(responseData.Metadata)
+	if err != nil {
+		resp.Diagnostics.AddError("Marshal issue", "Unable to marshal metadata into json for storage.")
+		return
+	}
+
+	data.Description = types.StringValue(responseData.Description)
+	data.TargetCredential = types.Int32Value(int32(responseData.TargetCredential))
+	data.InputFieldName = types.StringValue(responseData.InputFieldName)
+	data.SourceCredential = types.Int32Value(int32(responseData.SourceCredential))
+	data.Metadata = newJSONValue(string(metadata))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialInputSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialInputSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	bodyData, err := r.requestBody(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal map to json",
+			fmt.Sprintf("Unable to process metadata: %+v. ", data.Metadata))
+		return
+	}
+
+	url := r.client.APIPath("credential_input_sources/%d/", id)
+	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialInputSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialInputSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+
+	url := r.client.APIPath("credential_input_sources/%d/", id)
+	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API delete request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *CredentialInputSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
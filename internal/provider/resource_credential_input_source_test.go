@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitCredentialInputSourceResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var sourceId int
+
+	config := func(path string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "machine" {
+  name = "machine"
+  kind = "cloud"
+}
+
+resource "awx_credential_type" "vault" {
+  name = "vault lookup"
+  kind = "cloud"
+}
+
+resource "awx_credential" "vault" {
+  name            = "vault"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.vault.id
+  inputs = jsonencode({
+    url   = "https://vault.example.com"
+    token = "secret"
+  })
+}
+
+resource "awx_credential" "machine" {
+  name            = "machine"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.machine.id
+  inputs = jsonencode({
+    username = "deploy"
+  })
+}
+
+resource "awx_credential_input_source" "test" {
+  description       = "deploy password from vault"
+  target_credential = awx_credential.machine.id
+  input_field_name  = "password"
+  source_credential = awx_credential.vault.id
+  metadata = jsonencode({
+    secret_path = "` + path + `"
+    secret_key  = "password"
+  })
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("secret/deploy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_credential_input_source.test", "id"),
+					resource.TestCheckResourceAttr("awx_credential_input_source.test", "description", "deploy password from vault"),
+					resource.TestCheckResourceAttrPair("awx_credential_input_source.test", "target_credential", "awx_credential.machine", "id"),
+					resource.TestCheckResourceAttrPair("awx_credential_input_source.test", "source_credential", "awx_credential.vault", "id"),
+					resource.TestCheckResourceAttr("awx_credential_input_source.test", "input_field_name", "password"),
+					resource.TestCheckResourceAttr("awx_credential_input_source.test", "metadata", `{"secret_key":"password","secret_path":"secret/deploy"}`),
+					func(s *terraform.State) (err error) {
+						sourceId, err = strconv.Atoi(s.RootModule().Resources["awx_credential_input_source.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:      "awx_credential_input_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("secret/deploy/v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_credential_input_source.test", "metadata", `{"secret_key":"password","secret_path":"secret/deploy/v2"}`),
+					func(s *terraform.State) error {
+						obj, _ := server.Get("credential_input_sources", sourceId)
+						if path := obj["metadata"].(map[string]any)["secret_path"]; path != "secret/deploy/v2" {
+							return fmt.Errorf("expected the secret path to be updated, got %v", path)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// a lookup changed outside of terraform is drift
+				PreConfig: func() {
+					server.Update("credential_input_sources", sourceId, awxmock.Object{
+						"metadata": awxmock.Object{"secret_path": "secret/other", "secret_key": "password"},
+					})
+				},
+				Config:             config("secret/deploy/v2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitCredentialInputSourceResourceMetadataFormatting(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	targetId := server.Add("credentials", awxmock.Object{"name": "machine"})
+	sourceId := server.Add("credentials", awxmock.Object{"name": "vault"})
+
+	// a hand written document, formatted and ordered unlike the controller's
+	config := testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_credential_input_source" "test" {
+  target_credential = %d
+  input_field_name  = "password"
+  source_credential = %d
+  metadata          = <<-EOT
+    {
+      "secret_path": "secret/deploy",
+      "secret_key":  "password"
+    }
+  EOT
+}
+`, targetId, sourceId)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_credential_input_source" "test" {
+  target_credential = %d
+  input_field_name  = "password"
+  source_credential = %d
+  metadata          = "{not json"
+}
+`, targetId, sourceId),
+				ExpectError: regexp.MustCompile(`Invalid JSON`),
+			},
+			{
+				Config: config,
+				Check:  resource.TestMatchResourceAttr("awx_credential_input_source.test", "metadata", regexp.MustCompile(`^\{\n  "secret_path"`)),
+			},
+			{
+				// the controller's re-serialized document is no difference
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitCredentialResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  description     = "test credential"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs = jsonencode({
+    username = "admin"
+    password = "secret"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_credential.test", "id"),
+					resource.TestCheckResourceAttr("awx_credential.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_credential.test", "kind", ""),
+				),
+			},
+			{
+				ResourceName:            "awx_credential.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inputs"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "renamed"
+  description     = "renamed credential"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs = jsonencode({
+    username = "admin"
+    password = "rotated"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_credential.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_credential.test", "description", "renamed credential"),
+				),
+			},
+		},
+	})
+}
@@ -137,12 +137,10 @@ func (r *CredentialTypeResource) Create(ctx context.Context, req resource.Create
 		bodyData.Kind = data.Kind.ValueString()
 	}
 
-	url := "/api/v2/credential_types/"
+	url := r.client.APIPath("credential_types/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -178,7 +176,7 @@ func (r *CredentialTypeResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/credential_types/%d/", id)
+	url := r.client.APIPath("credential_types/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -334,12 +332,10 @@ func (r *CredentialTypeResource) Update(ctx context.Context, req resource.Update
 		bodyData.Kind = data.Kind.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/credential_types/%d/", id)
+	url := r.client.APIPath("credential_types/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -363,7 +359,7 @@ func (r *CredentialTypeResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/credential_types/%d/", id)
+	url := r.client.APIPath("credential_types/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
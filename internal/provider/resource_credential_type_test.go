@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitCredentialTypeResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_credential_type" "test" {
+  name        = "test"
+  description = "test credential type"
+  kind        = "cloud"
+  inputs = jsonencode({
+    fields = [{ id = "token", label = "Token", secret = true, type = "string" }]
+  })
+  injectors = jsonencode({
+    env = { TEST_TOKEN = "{{ token }}" }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_credential_type.test", "id"),
+					resource.TestCheckResourceAttr("awx_credential_type.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_credential_type.test", "kind", "cloud"),
+				),
+			},
+			{
+				ResourceName:      "awx_credential_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_credential_type" "test" {
+  name        = "renamed"
+  description = "renamed credential type"
+  kind        = "cloud"
+  inputs = jsonencode({
+    fields = [{ id = "api_token", label = "API Token", secret = true, type = "string" }]
+  })
+  injectors = jsonencode({
+    env = { TEST_TOKEN = "{{ api_token }}" }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_credential_type.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_credential_type.test", "description", "renamed credential type"),
+				),
+			},
+		},
+	})
+}
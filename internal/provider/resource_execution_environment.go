@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ExecutionEnvironmentResource{}
+var _ resource.ResourceWithImportState = &ExecutionEnvironmentResource{}
+
+func NewExecutionEnvironmentResource() resource.Resource {
+	return &ExecutionEnvironmentResource{}
+}
+
+type ExecutionEnvironmentResource struct {
+	client *AwxClient
+}
+
+func (r *ExecutionEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_environment"
+}
+
+func (r *ExecutionEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manage an AWX execution environment.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Execution Environment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Execution Environment name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Execution Environment description.",
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "The full image location, including the container registry, image name, and version tag.",
+			},
+			"pull": schema.StringAttribute{
+				Optional:    true,
+				Description: "always: always pull container before running, missing: only pull the image if not present before running, never: never pull container before running. Leave unset to use the controller's default.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"always", "missing", "never"}...),
+				},
+			},
+			"organization": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Organization ID the execution environment is limited to. Leave unset to make the execution environment globally available.",
+			},
+			"credential": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Credential to authenticate with a protected container registry.",
+			},
+		},
+	}
+}
+
+func (r *ExecutionEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+func (data ExecutionEnvironmentModel) requestBody() ExecutionEnvironmentAPIModel {
+	bodyData := ExecutionEnvironmentAPIModel{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Image:       data.Image.ValueString(),
+		Pull:        data.Pull.ValueString(),
+	}
+	if !data.Organization.IsNull() {
+		organization := int(data.Organization.ValueInt32())
+		bodyData.Organization = &organization
+	}
+	if !data.Credential.IsNull() {
+		credential := int(data.Credential.ValueInt32())
+		bodyData.Credential = &credential
+	}
+	return bodyData
+}
+
+func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExecutionEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.client.APIPath("execution_environments/")
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, data.requestBody(), []int{201})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExecutionEnvironmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("execution_environments/%d/", id)
+	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var responseData ExecutionEnvironmentAPIModel
+
+	err = json.Unmarshal(body, &responseData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return
+	}
+
+	data.Name = types.StringValue(responseData.Name)
+	data.Image = types.StringValue(responseData.Image)
+
+	if !(data.Description.IsNull() && responseData.Description == "") {
+		data.Description = types.StringValue(responseData.Description)
+	}
+
+	// a blank pull uses the controller's default
+	if !(data.Pull.IsNull() && responseData.Pull == "") {
+		data.Pull = types.StringValue(responseData.Pull)
+	}
+
+	data.Organization = types.Int32Null()
+	if responseData.Organization != nil {
+		data.Organization = types.Int32Value(int32(*responseData.Organization))
+	}
+
+	data.Credential = types.Int32Null()
+	if responseData.Credential != nil {
+		data.Credential = types.Int32Value(int32(*responseData.Credential))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExecutionEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("execution_environments/%d/", id)
+	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, data.requestBody(), []int{200})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExecutionEnvironmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+	url := r.client.APIPath("execution_environments/%d/", id)
+
+	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API delete request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *ExecutionEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitExecutionEnvironmentResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var eeId int
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_execution_environment" "test" {
+  name  = "test"
+  image = "quay.io/ansible/awx-ee:latest"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_execution_environment.test", "id"),
+					resource.TestCheckResourceAttr("awx_execution_environment.test", "image", "quay.io/ansible/awx-ee:latest"),
+					resource.TestCheckNoResourceAttr("awx_execution_environment.test", "pull"),
+					resource.TestCheckNoResourceAttr("awx_execution_environment.test", "organization"),
+				),
+			},
+			{
+				ResourceName:      "awx_execution_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test registry"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs          = jsonencode({})
+}
+
+resource "awx_execution_environment" "test" {
+  name         = "renamed"
+  description  = "renamed execution environment"
+  image        = "registry.example.com/ee:1.0"
+  pull         = "missing"
+  organization = awx_organization.test.id
+  credential   = awx_credential.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_execution_environment.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_execution_environment.test", "pull", "missing"),
+					resource.TestCheckResourceAttrPair("awx_execution_environment.test", "organization", "awx_organization.test", "id"),
+					resource.TestCheckResourceAttrPair("awx_execution_environment.test", "credential", "awx_credential.test", "id"),
+					func(s *terraform.State) (err error) {
+						eeId, err = strconv.Atoi(s.RootModule().Resources["awx_execution_environment.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:      "awx_execution_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// a change made outside of terraform is drift
+				PreConfig: func() {
+					server.Update("execution_environments", eeId, awxmock.Object{"pull": "always", "image": "registry.example.com/ee:2.0"})
+				},
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test registry"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs          = jsonencode({})
+}
+
+resource "awx_execution_environment" "test" {
+  name         = "renamed"
+  description  = "renamed execution environment"
+  image        = "registry.example.com/ee:1.0"
+  pull         = "missing"
+  organization = awx_organization.test.id
+  credential   = awx_credential.test.id
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test registry"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs          = jsonencode({})
+}
+
+resource "awx_execution_environment" "test" {
+  name         = "renamed"
+  description  = "renamed execution environment"
+  image        = "registry.example.com/ee:1.0"
+  pull         = "missing"
+  organization = awx_organization.test.id
+  credential   = awx_credential.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_execution_environment.test", "pull", "missing"),
+					resource.TestCheckResourceAttr("awx_execution_environment.test", "image", "registry.example.com/ee:1.0"),
+				),
+			},
+		},
+	})
+}
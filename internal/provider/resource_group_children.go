@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewGroupChildrenResource() resource.Resource {
+	return &membershipResource{
+		config: membershipConfig{
+			typeName:           "_group_children",
+			description:        "Manage the child groups nested under an AWX inventory group. The children must be in the group's inventory.",
+			parentAttribute:    "group_id",
+			parentDescription:  "The ID of the parent group.",
+			relatedPath:        "groups/%d/children/",
+			membersAttribute:   "child_ids",
+			membersDescription: "The IDs of the groups nested under the parent group.",
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitGroupChildrenResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	config := func(children string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_group" "parent" {
+  name      = "datacenter"
+  inventory = awx_inventory.test.id
+}
+
+resource "awx_group" "child" {
+  count     = 2
+  name      = "child-${count.index}"
+  inventory = awx_inventory.test.id
+}
+
+resource "awx_group_children" "test" {
+  group_id  = awx_group.parent.id
+  child_ids = ` + children + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_group.child[*].id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_children.test", "child_ids.#", "2"),
+					resource.TestCheckResourceAttr("awx_group_children.test", "authoritative", "true"),
+					testCheckRelatedCount(server, "awx_group_children.test", "group_id", "groups", "children", 2),
+				),
+			},
+			{
+				ResourceName:                         "awx_group_children.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_group_children.test", "group_id"),
+			},
+			{
+				Config: config("[awx_group.child[1].id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_children.test", "child_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("awx_group_children.test", "child_ids.0", "awx_group.child.1", "id"),
+					testCheckRelatedCount(server, "awx_group_children.test", "group_id", "groups", "children", 1),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewGroupHostsResource() resource.Resource {
+	return &membershipResource{
+		config: membershipConfig{
+			typeName:           "_group_hosts",
+			description:        "Manage the hosts that belong to an AWX inventory group. The hosts must be in the group's inventory.",
+			parentAttribute:    "group_id",
+			parentDescription:  "The ID of the group.",
+			relatedPath:        "groups/%d/hosts/",
+			membersAttribute:   "host_ids",
+			membersDescription: "The IDs of the hosts in the group.",
+		},
+	}
+}
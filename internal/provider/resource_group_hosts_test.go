@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitGroupHostsResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	outsiderId := server.Add("hosts", awxmock.Object{"name": "outsider"})
+	var groupId int
+
+	config := func(hosts, authoritative string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_group" "test" {
+  name      = "webservers"
+  inventory = awx_inventory.test.id
+}
+
+resource "awx_host" "test" {
+  count     = 2
+  name      = "web-${count.index}.example.com"
+  inventory = awx_inventory.test.id
+}
+
+resource "awx_group_hosts" "test" {
+  group_id      = awx_group.test.id
+  host_ids      = ` + hosts + `
+  authoritative = ` + authoritative + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_host.test[*].id", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_hosts.test", "host_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_group_hosts.test", "group_id", "groups", "hosts", 2),
+					func(s *terraform.State) (err error) {
+						groupId, err = strconv.Atoi(s.RootModule().Resources["awx_group.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:                         "awx_group_hosts.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_group_hosts.test", "group_id"),
+			},
+			{
+				Config: config("[awx_host.test[0].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_hosts.test", "host_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_group_hosts.test", "group_id", "groups", "hosts", 1),
+				),
+			},
+			{
+				// a host added outside of terraform is drift when authoritative
+				PreConfig: func() {
+					server.Associate("groups", groupId, "hosts", outsiderId)
+				},
+				Config:             config("[awx_host.test[0].id]", "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config("[awx_host.test[0].id]", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_hosts.test", "authoritative", "false"),
+					testCheckRelatedCount(server, "awx_group_hosts.test", "group_id", "groups", "hosts", 1),
+				),
+			},
+			{
+				// and is left alone when additive
+				PreConfig: func() {
+					server.Associate("groups", groupId, "hosts", outsiderId)
+				},
+				Config: config("awx_host.test[*].id", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group_hosts.test", "host_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_group_hosts.test", "group_id", "groups", "hosts", 3),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitGroupResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var groupId int
+
+	config := func(name, variables string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_group" "test" {
+  name        = "` + name + `"
+  description = "test group"
+  inventory   = awx_inventory.test.id
+  variables   = <<-YAML
+` + variables + `
+  YAML
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("webservers", "    http_port: 80\n    ntp_server: ntp.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_group.test", "id"),
+					resource.TestCheckResourceAttr("awx_group.test", "name", "webservers"),
+					resource.TestCheckResourceAttr("awx_group.test", "description", "test group"),
+					resource.TestCheckResourceAttrPair("awx_group.test", "inventory", "awx_inventory.test", "id"),
+					resource.TestCheckResourceAttr("awx_group.test", "variables", "http_port: 80\nntp_server: ntp.example.com\n"),
+					func(s *terraform.State) (err error) {
+						groupId, err = strconv.Atoi(s.RootModule().Resources["awx_group.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:      "awx_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the same variables stored as json are not a change
+				PreConfig: func() {
+					server.Update("groups", groupId, awxmock.Object{
+						"variables": `{"ntp_server": "ntp.example.com", "http_port": 80}`,
+					})
+				},
+				Config:   config("webservers", "    http_port: 80\n    ntp_server: ntp.example.com"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("web", "    http_port: 8080"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_group.test", "name", "web"),
+					resource.TestCheckResourceAttr("awx_group.test", "variables", "http_port: 8080\n"),
+				),
+			},
+			{
+				// a group deleted outside of terraform is removed from state and planned again
+				PreConfig: func() {
+					server.Delete("groups", groupId)
+				},
+				Config:             config("web", "    http_port: 8080"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config("web", "    http_port: 8080"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["awx_group.test"].Primary.ID; id == strconv.Itoa(groupId) {
+							return fmt.Errorf("expected the group to be created again, it still has id %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -114,12 +114,10 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		bodyData.Variables = data.Variables.ValueString()
 	}
 
-	url := "/api/v2/hosts/"
+	url := r.client.APIPath("hosts/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -154,7 +152,7 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/hosts/%d/", id)
+	url := r.client.APIPath("hosts/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -226,12 +224,10 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		bodyData.Variables = data.Variables.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/hosts/%d/", id)
+	url := r.client.APIPath("hosts/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -254,7 +250,7 @@ func (r *HostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/hosts/%d/", id)
+	url := r.client.APIPath("hosts/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
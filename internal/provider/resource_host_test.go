@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitHostResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_host" "test" {
+  name        = "host.example.com"
+  description = "test host"
+  inventory   = awx_inventory.test.id
+  variables   = jsonencode({ ansible_host = "192.0.2.10" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_host.test", "id"),
+					resource.TestCheckResourceAttr("awx_host.test", "name", "host.example.com"),
+					resource.TestCheckResourceAttr("awx_host.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "awx_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_host" "test" {
+  name        = "renamed.example.com"
+  description = "renamed host"
+  inventory   = awx_inventory.test.id
+  enabled     = false
+  variables   = jsonencode({ ansible_host = "192.0.2.11" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_host.test", "name", "renamed.example.com"),
+					resource.TestCheckResourceAttr("awx_host.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &InstanceGroupResource{}
+var _ resource.ResourceWithImportState = &InstanceGroupResource{}
+
+func NewInstanceGroupResource() resource.Resource {
+	return &InstanceGroupResource{}
+}
+
+type InstanceGroupResource struct {
+	client *AwxClient
+}
+
+func (r *InstanceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_group"
+}
+
+func (r *InstanceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an AWX instance group, or a container group that runs jobs as pods in a Kubernetes or OpenShift cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Instance Group ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance Group name.",
+			},
+			"is_container_group": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the group is a container group, running jobs as pods in a cluster rather than on instances.",
+			},
+			"credential": schema.Int32Attribute{
+				Optional:    true,
+				Description: "ID of the OpenShift or Kubernetes API bearer token credential a container group uses to reach its cluster. Leave unset to use the service account of the controller's own pod.",
+			},
+			"pod_spec_override": schema.StringAttribute{
+				CustomType:  yamlType{},
+				Optional:    true,
+				Description: "A container group's custom pod spec, as YAML. Documents that decode to the same value are considered equal.",
+			},
+			"policy_instance_percentage": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "Minimum percentage of all instances that will be automatically assigned to this group when new instances come online.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, 100),
+				},
+			},
+			"policy_instance_minimum": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "Minimum number of instances that will be automatically assigned to this group when new instances come online.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"policy_instance_list": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description: "Hostnames of the instances that will always be assigned to this group.",
+			},
+			"max_concurrent_jobs": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "Maximum number of jobs to run concurrently on this group. Zero means no limit will be enforced.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"max_forks": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "Maximum number of forks to allow across all jobs running concurrently on this group. Zero means no limit will be enforced.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r InstanceGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// instances are never assigned to a container group
+		resourcevalidator.Conflicting(path.MatchRoot("pod_spec_override"), path.MatchRoot("policy_instance_list")),
+		resourcevalidator.Conflicting(path.MatchRoot("credential"), path.MatchRoot("policy_instance_list")),
+	}
+}
+
+func (r *InstanceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+func (data InstanceGroupModel) requestBody(ctx context.Context) (bodyData InstanceGroupAPIModel, diags diag.Diagnostics) {
+	bodyData = InstanceGroupAPIModel{
+		Name:                     data.Name.ValueString(),
+		IsContainerGroup:         data.IsContainerGroup.ValueBool(),
+		PodSpecOverride:          data.PodSpecOverride.ValueString(),
+		PolicyInstancePercentage: int(data.PolicyInstancePercentage.ValueInt32()),
+		PolicyInstanceMinimum:    int(data.PolicyInstanceMinimum.ValueInt32()),
+		PolicyInstanceList:       []string{},
+		MaxConcurrentJobs:        int(data.MaxConcurrentJobs.ValueInt32()),
+		MaxForks:                 int(data.MaxForks.ValueInt32()),
+	}
+	if !data.Credential.IsNull() {
+		credential := int(data.Credential.ValueInt32())
+		bodyData.Credential = &credential
+	}
+	diags = data.PolicyInstanceList.ElementsAs(ctx, &bodyData.PolicyInstanceList, false)
+	return
+}
+
+func (r *InstanceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bodyData, diags := data.requestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.client.APIPath("instance_groups/")
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("instance_groups/%d/", id)
+	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var responseData InstanceGroupAPIModel
+
+	err = json.Unmarshal(body, &responseData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return
+	}
+
+	data.Name = types.StringValue(responseData.Name)
+	data.IsContainerGroup = types.BoolValue(responseData.IsContainerGroup)
+	data.PolicyInstancePercentage = types.Int32Value(int32(responseData.PolicyInstancePercentage))
+	data.PolicyInstanceMinimum = types.Int32Value(int32(responseData.PolicyInstanceMinimum))
+	data.MaxConcurrentJobs = types.Int32Value(int32(responseData.MaxConcurrentJobs))
+	data.MaxForks = types.Int32Value(int32(responseData.MaxForks))
+
+	data.Credential = types.Int32Null()
+	if responseData.Credential != nil {
+		data.Credential = types.Int32Value(int32(*responseData.Credential))
+	}
+
+	// a blank pod spec uses the controller's default
+	if responseData.PodSpecOverride == "" {
+		data.PodSpecOverride = newYAMLNull()
+	} else {
+		data.PodSpecOverride = newYAMLValue(responseData.PodSpecOverride)
+	}
+
+	if responseData.PolicyInstanceList == nil {
+		responseData.PolicyInstanceList = []string{}
+	}
+	policyInstanceList, diags := types.SetValueFrom(ctx, types.StringType, responseData.PolicyInstanceList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PolicyInstanceList = policyInstanceList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InstanceGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	bodyData, diags := data.requestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.client.APIPath("instance_groups/%d/", id)
+	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InstanceGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+	url := r.client.APIPath("instance_groups/%d/", id)
+
+	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API delete request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *InstanceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
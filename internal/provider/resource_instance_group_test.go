@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitInstanceGroupResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_instance_group" "test" {
+  name              = "openshift"
+  pod_spec_override = "kind: [Pod"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid YAML`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_instance_group" "test" {
+  name                       = "test"
+  policy_instance_percentage = 50
+  policy_instance_minimum    = 1
+  policy_instance_list       = ["awx-1.example.com", "awx-2.example.com"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_instance_group.test", "id"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "is_container_group", "false"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "policy_instance_list.#", "2"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "max_forks", "0"),
+				),
+			},
+			{
+				ResourceName:      "awx_instance_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_instance_group" "test" {
+  name                = "renamed"
+  max_concurrent_jobs = 10
+  max_forks           = 50
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_instance_group.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "policy_instance_percentage", "0"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "policy_instance_list.#", "0"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "max_concurrent_jobs", "10"),
+					resource.TestCheckResourceAttr("awx_instance_group.test", "max_forks", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitInstanceGroupResourceContainerGroup(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var groupId int
+
+	config := testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_credential_type" "test" {
+  name = "test cluster"
+  kind = "cloud"
+}
+
+resource "awx_credential" "test" {
+  name            = "test"
+  organization    = awx_organization.test.id
+  credential_type = awx_credential_type.test.id
+  inputs          = jsonencode({})
+}
+
+resource "awx_instance_group" "test" {
+  name               = "openshift"
+  is_container_group = true
+  credential         = awx_credential.test.id
+  pod_spec_override  = <<-EOT
+    apiVersion: v1
+    kind: Pod
+    metadata:
+      namespace: awx-jobs
+    spec:
+      containers:
+        - image: quay.io/ansible/awx-ee:latest
+          name: worker
+  EOT
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_instance_group.test", "is_container_group", "true"),
+					resource.TestCheckResourceAttrPair("awx_instance_group.test", "credential", "awx_credential.test", "id"),
+					resource.TestMatchResourceAttr("awx_instance_group.test", "pod_spec_override", regexp.MustCompile(`namespace: awx-jobs`)),
+					func(s *terraform.State) (err error) {
+						groupId, err = strconv.Atoi(s.RootModule().Resources["awx_instance_group.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:      "awx_instance_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the same pod spec formatted differently is not a change
+				PreConfig: func() {
+					server.Update("instance_groups", groupId, awxmock.Object{
+						"pod_spec_override": `{"kind": "Pod", "apiVersion": "v1", "metadata": {"namespace": "awx-jobs"}, "spec": {"containers": [{"name": "worker", "image": "quay.io/ansible/awx-ee:latest"}]}}`,
+					})
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// while a different one is
+				PreConfig: func() {
+					server.Update("instance_groups", groupId, awxmock.Object{
+						"pod_spec_override": "apiVersion: v1\nkind: Pod\nmetadata:\n  namespace: default\n",
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
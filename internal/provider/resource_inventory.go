@@ -132,12 +132,10 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 		bodyData.HostFilter = data.HostFilter.ValueString()
 	}
 
-	url := "/api/v2/inventories/"
+	url := r.client.APIPath("inventories/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -172,7 +170,7 @@ func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/inventories/%d/", id)
+	url := r.client.APIPath("inventories/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -276,12 +274,10 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 		bodyData.HostFilter = data.HostFilter.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/inventories/%d/", id)
+	url := r.client.APIPath("inventories/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -304,7 +300,7 @@ func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/inventories/%d/", id)
+	url := r.client.APIPath("inventories/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -24,6 +24,13 @@ import (
 
 var _ resource.Resource = &InventorySourceResource{}
 var _ resource.ResourceWithImportState = &InventorySourceResource{}
+var _ resource.ResourceWithModifyPlan = &InventorySourceResource{}
+
+// inventorySourceFeatures are the sources that only newer controllers have.
+var inventorySourceFeatures = map[string]controllerFeature{
+	"terraform":                featureTerraformInventorySource,
+	"openshift_virtualization": featureOpenShiftVirtualizationInventorySource,
+}
 
 func NewInventorySourceResource() resource.Resource {
 	return &InventorySourceResource{}
@@ -217,6 +224,27 @@ func (r *InventorySourceResource) Configure(ctx context.Context, req resource.Co
 	r.client = configureData
 }
 
+// ModifyPlan rejects sources the configured controller is too old to support.
+func (r *InventorySourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var source types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source"), &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, ok := inventorySourceFeatures[source.ValueString()]
+	if ok && !r.client.supports(feature) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Attribute not supported by controller version",
+			r.client.unsupportedFeatureDetail(feature))
+	}
+}
+
 func (r *InventorySourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data InventorySourceModel
 
@@ -277,12 +305,10 @@ func (r *InventorySourceResource) Create(ctx context.Context, req resource.Creat
 		bodyData.Verbosity = int(data.Verbosity.ValueInt32())
 	}
 
-	url := "/api/v2/inventory_sources/"
+	url := r.client.APIPath("inventory_sources/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -317,7 +343,7 @@ func (r *InventorySourceResource) Read(ctx context.Context, req resource.ReadReq
 			fmt.Sprintf("Unable to convert id: %v.", data.Id))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/inventory_sources/%d/", id)
+	url := r.client.APIPath("inventory_sources/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -520,12 +546,10 @@ func (r *InventorySourceResource) Update(ctx context.Context, req resource.Updat
 		bodyData.Verbosity = int(data.Verbosity.ValueInt32())
 	}
 
-	url := fmt.Sprintf("/api/v2/inventory_sources/%d/", id)
+	url := r.client.APIPath("inventory_sources/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -549,7 +573,7 @@ func (r *InventorySourceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/inventory_sources/%d/", id)
+	url := r.client.APIPath("inventory_sources/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
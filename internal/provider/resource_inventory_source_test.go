@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitInventorySourceResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_inventory_source" "test" {
+  name           = "test"
+  description    = "test inventory source"
+  inventory      = awx_inventory.test.id
+  source         = "scm"
+  source_project = awx_project.test.id
+  source_path    = "inventory.yml"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_inventory_source.test", "id"),
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "source", "scm"),
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "overwrite", "false"),
+				),
+			},
+			{
+				ResourceName:      "awx_inventory_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Read only refreshes these attributes once they are in state.
+				ImportStateVerifyIgnore: []string{"overwrite", "overwrite_vars", "source_project", "update_cache_timeout", "update_on_launch", "verbosity"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_inventory_source" "test" {
+  name             = "renamed"
+  description      = "renamed inventory source"
+  inventory        = awx_inventory.test.id
+  source           = "scm"
+  source_project   = awx_project.test.id
+  source_path      = "hosts.yml"
+  overwrite        = true
+  update_on_launch = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "overwrite", "true"),
+					resource.TestCheckResourceAttr("awx_inventory_source.test", "update_on_launch", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitInventorySourceResourceUnsupportedVersion(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+	server.Version = "4.4.2"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_inventory_source" "test" {
+  name      = "test"
+  inventory = 1
+  source    = "terraform"
+}
+`,
+				ExpectError: regexp.MustCompile(`terraform inventory source\s+requires AAP controller 4\.5\.0 or later`),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitInventoryResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  description  = "test inventory"
+  organization = awx_organization.test.id
+  variables    = jsonencode({ env = "test" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_inventory.test", "id"),
+					resource.TestCheckResourceAttr("awx_inventory.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_inventory.test", "description", "test inventory"),
+				),
+			},
+			{
+				ResourceName:      "awx_inventory.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "renamed"
+  description  = "renamed inventory"
+  organization = awx_organization.test.id
+  variables    = jsonencode({ env = "prod" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_inventory.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_inventory.test", "description", "renamed inventory"),
+				),
+			},
+		},
+	})
+}
@@ -23,6 +23,7 @@ import (
 
 var _ resource.Resource = &JobTemplateResource{}
 var _ resource.ResourceWithImportState = &JobTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &JobTemplateResource{}
 
 func NewJobTemplateResource() resource.Resource {
 	return &JobTemplateResource{}
@@ -309,10 +310,14 @@ func (r *JobTemplateResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 		},
 	}
+
+	for name, attribute := range webhookAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r JobTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data JobTemplateModel
+	var data JobTemplateResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -356,8 +361,21 @@ func (r *JobTemplateResource) Configure(ctx context.Context, req resource.Config
 	r.client = configureData
 }
 
+// ModifyPlan rejects attributes the configured controller is too old to support, and keeps
+// the webhook key and URL known when they are not going to change.
+func (r *JobTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.checkBoolFeature(ctx, req.Config, path.Root("prevent_instance_group_fallback"), featurePreventInstanceGroupFallback, &resp.Diagnostics)
+	r.client.checkBoolFeature(ctx, req.Config, path.Root("ask_labels_on_launch"), featurePromptLabelsAndInstanceGroups, &resp.Diagnostics)
+	r.client.checkBoolFeature(ctx, req.Config, path.Root("ask_instance_groups_on_launch"), featurePromptLabelsAndInstanceGroups, &resp.Diagnostics)
+	planWebhook(ctx, req, resp)
+}
+
 func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data JobTemplateModel
+	var data JobTemplateResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -497,12 +515,10 @@ func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateReq
 		bodyData.PreventInstanceGroupFallback = data.PreventInstanceGroupFallback.ValueBool()
 	}
 
-	url := "/api/v2/job_templates/"
+	url := r.client.APIPath("job_templates/")
 	returnedData, statusCode, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{200, 201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -524,11 +540,26 @@ func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateReq
 
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
+		return
+	}
+
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "job_templates", id, returnedData, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.WebhookKey = types.StringValue(webhookKey)
+	data.WebhookURL = types.StringValue(webhookURL)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data JobTemplateModel
+	var data JobTemplateResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -543,7 +574,7 @@ func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/", id)
+	url := r.client.APIPath("job_templates/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -826,10 +857,25 @@ func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var template map[string]any
+	if err = json.Unmarshal(body, &template); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable unmarshal response body into object",
+			fmt.Sprintf("Error =  %v. ", err.Error()))
+		return
+	}
+
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "job_templates", id, template, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_key"), webhookKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_url"), webhookURL)...)
 }
 
 func (r *JobTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data JobTemplateModel
+	var data JobTemplateResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -891,20 +937,33 @@ func (r *JobTemplateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/", id)
-	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
+	url := r.client.APIPath("job_templates/%d/", id)
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	var rotateWebhookKey types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_webhook_key"), &rotateWebhookKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only a template with a webhook service has a key to rotate
+	rotate := data.WebhookService.ValueString() != "" && !data.RotateWebhookKey.Equal(rotateWebhookKey)
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "job_templates", id, returnedData, rotate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.WebhookKey = types.StringValue(webhookKey)
+	data.WebhookURL = types.StringValue(webhookURL)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *JobTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data JobTemplateModel
+	var data JobTemplateResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -918,7 +977,7 @@ func (r *JobTemplateResource) Delete(ctx context.Context, req resource.DeleteReq
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/job_templates/%d/", id)
+	url := r.client.APIPath("job_templates/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -30,11 +29,6 @@ type JobTemplateCredentialResourceModel struct {
 	CredentialIds types.Set    `tfsdk:"credential_ids"`
 }
 
-type JTCredentialAPIRead struct {
-	Count   int      `json:"count"`
-	Results []Result `json:"results"`
-}
-
 type Result struct {
 	Id int `json:"id"`
 }
@@ -100,7 +94,7 @@ func (r *JobTemplateCredentialResource) Create(ctx context.Context, req resource
 			fmt.Sprintf("Unable to convert id: %v. ", data.JobTemplateId.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", id)
+	url := r.client.APIPath("job_templates/%d/credentials/", id)
 
 	var credIds []int
 
@@ -139,9 +133,9 @@ func (r *JobTemplateCredentialResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", id)
+	url := r.client.APIPath("job_templates/%d/credentials/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	tfCredIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -149,22 +143,6 @@ func (r *JobTemplateCredentialResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	var responseData JTCredentialAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	tfCredIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		tfCredIds = append(tfCredIds, v.Id)
-	}
-
 	listValue, diags := types.SetValueFrom(ctx, types.Int32Type, tfCredIds)
 	if diags.HasError() {
 		return
@@ -189,9 +167,9 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", id)
+	url := r.client.APIPath("job_templates/%d/credentials/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	ApiTfCredIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -199,22 +177,6 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	var responseData JTCredentialAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	ApiTfCredIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		ApiTfCredIds = append(ApiTfCredIds, v.Id)
-	}
-
 	var PlanCredIds []int
 	diags := data.CredentialIds.ElementsAs(ctx, &PlanCredIds, false)
 	if diags.HasError() {
@@ -227,6 +189,7 @@ func (r *JobTemplateCredentialResource) Update(ctx context.Context, req resource
 		if !slices.Contains(PlanCredIds, v) {
 			var bodyData DissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
 			if err != nil {
@@ -275,7 +238,7 @@ func (r *JobTemplateCredentialResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", id)
+	url := r.client.APIPath("job_templates/%d/credentials/", id)
 
 	for _, val := range credIds {
 
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -86,7 +85,7 @@ func (r *JobTemplateInstanceGroupsResource) Create(ctx context.Context, req reso
 			fmt.Sprintf("Unable to convert id: %v. ", data.JobTemplateId.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/instance_groups/", id)
+	url := r.client.APIPath("job_templates/%d/instance_groups/", id)
 
 	var relatedIds []int
 
@@ -125,9 +124,9 @@ func (r *JobTemplateInstanceGroupsResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/instance_groups/", id)
+	url := r.client.APIPath("job_templates/%d/instance_groups/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	tfRelatedIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -135,22 +134,6 @@ func (r *JobTemplateInstanceGroupsResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	var responseData JTChildAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	tfRelatedIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		tfRelatedIds = append(tfRelatedIds, v.Id)
-	}
-
 	listValue, diags := types.SetValueFrom(ctx, types.Int32Type, tfRelatedIds)
 	if diags.HasError() {
 		return
@@ -175,9 +158,9 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/instance_groups/", id)
+	url := r.client.APIPath("job_templates/%d/instance_groups/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	ApiTfChildIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -185,22 +168,6 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	var responseData JTChildAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	ApiTfChildIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		ApiTfChildIds = append(ApiTfChildIds, v.Id)
-	}
-
 	var PlanChildIds []int
 	diags := data.InstanceGroupsIDs.ElementsAs(ctx, &PlanChildIds, false)
 	if diags.HasError() {
@@ -213,6 +180,7 @@ func (r *JobTemplateInstanceGroupsResource) Update(ctx context.Context, req reso
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
 			if err != nil {
@@ -254,7 +222,7 @@ func (r *JobTemplateInstanceGroupsResource) Delete(ctx context.Context, req reso
 			fmt.Sprintf("Unable to convert id: %v. ", data.JobTemplateId.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/instance_groups/", id)
+	url := r.client.APIPath("job_templates/%d/instance_groups/", id)
 
 	var RelatedIds []int
 
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitJobTemplateInstanceGroupsResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	first := server.Add("instance_groups", awxmock.Object{"name": "default"})
+	second := server.Add("instance_groups", awxmock.Object{"name": "controlplane"})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_job_template_instance_group" "test" {
+  job_template_id     = awx_job_template.test.id
+  instance_groups_ids = [%d, %d]
+}
+`, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_instance_group.test", "instance_groups_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_job_template_instance_group.test", "job_template_id", "job_templates", "instance_groups", 2),
+				),
+			},
+			{
+				ResourceName:                         "awx_job_template_instance_group.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "job_template_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_job_template_instance_group.test", "job_template_id"),
+			},
+			{
+				Config: testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_job_template_instance_group" "test" {
+  job_template_id     = awx_job_template.test.id
+  instance_groups_ids = [%d]
+}
+`, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_instance_group.test", "instance_groups_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_job_template_instance_group.test", "job_template_id", "job_templates", "instance_groups", 1),
+				),
+			},
+		},
+	})
+}
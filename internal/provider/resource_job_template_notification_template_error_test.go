@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitJobTemplateNotifTemplErrResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	outsiderId := server.Add("notification_templates", awxmock.Object{"name": "outsider"})
+	var jobTemplateId int
+
+	config := func(jobTemplate, notificationTemplates string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  count    = 2
+  name     = "test-${count.index}"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_notification_template" "test" {
+  count             = 2
+  name              = "test-${count.index}"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    channels  = ["#alerts"]
+    hex_color = ""
+    token     = "secret"
+  })
+}
+
+resource "awx_job_template_notification_template_error" "test" {
+  job_template_id    = ` + jobTemplate + `
+  notif_template_ids = ` + notificationTemplates + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_job_template.test[0].id", "awx_notification_template.test[*].id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_notification_template_error.test", "notif_template_ids.#", "2"),
+					resource.TestCheckResourceAttr("awx_job_template_notification_template_error.test", "authoritative", "false"),
+					testCheckRelatedCount(server, "awx_job_template_notification_template_error.test", "job_template_id", "job_templates", "notification_templates_error", 2),
+					func(s *terraform.State) (err error) {
+						jobTemplateId, err = strconv.Atoi(s.RootModule().Resources["awx_job_template.test.0"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:                         "awx_job_template_notification_template_error.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "job_template_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_job_template_notification_template_error.test", "job_template_id"),
+			},
+			{
+				// a notification template attached outside of terraform is left alone
+				PreConfig: func() {
+					server.Associate("job_templates", jobTemplateId, "notification_templates_error", outsiderId)
+				},
+				Config: config("awx_job_template.test[0].id", "[awx_notification_template.test[1].id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_notification_template_error.test", "notif_template_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_job_template_notification_template_error.test", "job_template_id", "job_templates", "notification_templates_error", 2),
+				),
+			},
+			{
+				// moving to another job template updates in place, taking only our notification templates along
+				Config: config("awx_job_template.test[1].id", "[awx_notification_template.test[1].id]"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("awx_job_template_notification_template_error.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRelatedCount(server, "awx_job_template_notification_template_error.test", "job_template_id", "job_templates", "notification_templates_error", 1),
+					testCheckRelatedCount(server, "awx_job_template.test.0", "id", "job_templates", "notification_templates_error", 1),
+				),
+			},
+		},
+	})
+}
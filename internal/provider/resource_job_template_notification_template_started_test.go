@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitJobTemplateNotifTemplStartedResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_notification_template" "test" {
+  count             = 2
+  name              = "test-${count.index}"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    channels  = ["#alerts"]
+    hex_color = ""
+    token     = "secret"
+  })
+}
+
+resource "awx_job_template_notification_template_started" "test" {
+  job_template_id    = awx_job_template.test.id
+  notif_template_ids = awx_notification_template.test[*].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_notification_template_started.test", "notif_template_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_job_template_notification_template_started.test", "job_template_id", "job_templates", "notification_templates_started", 2),
+				),
+			},
+			{
+				ResourceName:                         "awx_job_template_notification_template_started.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "job_template_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_job_template_notification_template_started.test", "job_template_id"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_notification_template" "test" {
+  count             = 2
+  name              = "test-${count.index}"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    channels  = ["#alerts"]
+    hex_color = ""
+    token     = "secret"
+  })
+}
+
+resource "awx_job_template_notification_template_started" "test" {
+  job_template_id    = awx_job_template.test.id
+  notif_template_ids = [awx_notification_template.test[1].id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_notification_template_started.test", "notif_template_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_job_template_notification_template_started.test", "job_template_id", "job_templates", "notification_templates_started", 1),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// jobTemplateNotificationMembershipConfig is the config of the job template notification resources,
+// deprecated by awx_notification_attachment. They keep their original, additive, behaviour.
+func jobTemplateNotificationMembershipConfig(event string) membershipConfig {
+	return membershipConfig{
+		typeName: "_job_template_notification_template_" + event,
+		description: "Associate awx_notification_template(s) to a job template. " +
+			"Do not manage the " + event + " notification templates of a job template with both this resource and an authoritative `awx_notification_attachment`, " +
+			"each would remove the notification templates the other associates.",
+		parentAttribute:    "job_template_id",
+		parentDescription:  "The ID of the containing Job Template.",
+		relatedPath:        "job_templates/%d/notification_templates_" + event + "/",
+		membersAttribute:   "notif_template_ids",
+		membersDescription: "An unordered list of `awx_notification_template` IDs associated to a particular Job Template.",
+		deprecationMessage: fmt.Sprintf("Use awx_notification_attachment with parent_type = \"job_template\" and event = %q instead.", event),
+		additive:           true,
+		parentInPlace:      true,
+	}
+}
+
+func NewJobTemplateNotifTemplStartedResource() resource.Resource {
+	return &membershipResource{config: jobTemplateNotificationMembershipConfig("started")}
+}
+
+func NewJobTemplateNotifTemplSuccessResource() resource.Resource {
+	return &membershipResource{config: jobTemplateNotificationMembershipConfig("success")}
+}
+
+func NewJobTemplateNotifTemplErrResource() resource.Resource {
+	return &membershipResource{config: jobTemplateNotificationMembershipConfig("error")}
+}
@@ -175,7 +175,7 @@ func (r *JobTemplateSurveyResource) Create(ctx context.Context, req resource.Cre
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec", id)
+	url := r.client.APIPath("job_templates/%d/survey_spec", id)
 
 	var bodyData JobTemplateSurvey
 	bodyData.Name = data.Name.ValueString()
@@ -231,9 +231,7 @@ func (r *JobTemplateSurveyResource) Create(ctx context.Context, req resource.Cre
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -255,7 +253,7 @@ func (r *JobTemplateSurveyResource) Read(ctx context.Context, req resource.ReadR
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec", id)
+	url := r.client.APIPath("job_templates/%d/survey_spec", id)
 
 	httpResponse, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
 	if err != nil {
@@ -380,7 +378,7 @@ func (r *JobTemplateSurveyResource) Update(ctx context.Context, req resource.Upd
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec", id)
+	url := r.client.APIPath("job_templates/%d/survey_spec", id)
 
 	var bodyData JobTemplateSurvey
 	bodyData.Name = data.Name.ValueString()
@@ -436,9 +434,7 @@ func (r *JobTemplateSurveyResource) Update(ctx context.Context, req resource.Upd
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -461,7 +457,7 @@ func (r *JobTemplateSurveyResource) Delete(ctx context.Context, req resource.Del
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec", id)
+	url := r.client.APIPath("job_templates/%d/survey_spec", id)
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{200})
 	if err != nil {
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitJobTemplateSurveyResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_job_template_survey_spec" "test" {
+  id          = awx_job_template.test.id
+  name        = "test"
+  description = "test survey"
+  spec = [
+    {
+      type                 = "text"
+      question_name        = "Name"
+      question_description = "Who to greet"
+      variable             = "greeting_name"
+      required             = true
+      default              = "world"
+      min                  = 0
+      max                  = 64
+    },
+    {
+      type                 = "multiplechoice"
+      question_name        = "Color"
+      question_description = "Favourite color"
+      variable             = "color"
+      required             = false
+      choices              = ["red", "green"]
+      default              = "red"
+      min                  = 0
+      max                  = 0
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "spec.#", "2"),
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "spec.1.choices.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "awx_job_template_survey_spec.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_job_template_survey_spec" "test" {
+  id          = awx_job_template.test.id
+  name        = "renamed"
+  description = "renamed survey"
+  spec = [
+    {
+      type                 = "integer"
+      question_name        = "Count"
+      question_description = "How many times"
+      variable             = "count"
+      required             = false
+      default              = "3"
+      min                  = 1
+      max                  = 10
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "spec.#", "1"),
+					resource.TestCheckResourceAttr("awx_job_template_survey_spec.test", "spec.0.default", "3"),
+				),
+			},
+		},
+	})
+}
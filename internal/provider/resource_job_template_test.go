@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestUnitJobTemplateResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name        = "test"
+  description = "test job template"
+  inventory   = awx_inventory.test.id
+  project     = awx_project.test.id
+  playbook    = "hello_world.yml"
+  limit       = "all"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_job_template.test", "id"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "job_type", "run"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "verbosity", "0"),
+				),
+			},
+			{
+				ResourceName:      "awx_job_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name                    = "renamed"
+  description             = "renamed job template"
+  inventory               = awx_inventory.test.id
+  project                 = awx_project.test.id
+  playbook                = "hello_world.yml"
+  limit                   = "web"
+  verbosity               = 2
+  ask_variables_on_launch = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "verbosity", "2"),
+					resource.TestCheckResourceAttr("awx_job_template.test", "ask_variables_on_launch", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitJobTemplateResourceUnsupportedVersion(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+	server.Version = "21.5.0"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_job_template" "test" {
+  name                            = "test"
+  project                         = 1
+  playbook                        = "hello_world.yml"
+  ask_inventory_on_launch         = true
+  prevent_instance_group_fallback = true
+}
+`,
+				ExpectError: regexp.MustCompile(`prevent_instance_group_fallback\s+requires AWX 21\.7\.0 or later`),
+			},
+		},
+	})
+}
+
+func TestUnitJobTemplateResourceWebhook(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	config := func(description, webhookService, rotate string) string {
+		return testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_job_template" "test" {
+  name                    = "test"
+  description             = %q
+  project                 = 1
+  playbook                = "hello_world.yml"
+  ask_inventory_on_launch = true
+  webhook_service         = %q
+  rotate_webhook_key      = %q
+}
+`, description, webhookService, rotate)
+	}
+
+	var key string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("test", "github", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("awx_job_template.test", "webhook_url", regexp.MustCompile(`^http://.+/api/v2/job_templates/\d+/github/$`)),
+					testCheckWebhookKey(server, "awx_job_template.test", "job_templates", &key, true),
+				),
+			},
+			{
+				ResourceName:            "awx_job_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_webhook_key"},
+			},
+			{
+				// an unrelated change keeps the key
+				Config: config("renamed", "github", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("awx_job_template.test", tfjsonpath.New("webhook_key"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("awx_job_template.test", tfjsonpath.New("webhook_url"), knownvalue.StringRegexp(regexp.MustCompile(`/github/$`))),
+					},
+				},
+				Check: testCheckWebhookKey(server, "awx_job_template.test", "job_templates", &key, false),
+			},
+			{
+				// a user without admin rights on the template can still refresh it
+				PreConfig: func() {
+					server.WebhookKeysForbidden = true
+				},
+				Config:   config("renamed", "github", "1"),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					server.WebhookKeysForbidden = false
+				},
+				Config: config("renamed", "github", "2"),
+				Check:  testCheckWebhookKey(server, "awx_job_template.test", "job_templates", &key, true),
+			},
+			{
+				Config: config("renamed", "gitlab", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("awx_job_template.test", "webhook_url", regexp.MustCompile(`/gitlab/$`)),
+					testCheckWebhookKey(server, "awx_job_template.test", "job_templates", &key, true),
+				),
+			},
+			{
+				Config: config("renamed", "", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_job_template.test", "webhook_key", ""),
+					resource.TestCheckResourceAttr("awx_job_template.test", "webhook_url", ""),
+				),
+			},
+		},
+	})
+}
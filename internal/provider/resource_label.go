@@ -87,12 +87,10 @@ func (r *LabelsResource) Create(ctx context.Context, req resource.CreateRequest,
 	bodyData.Name = data.Name.ValueString()
 	bodyData.Organization = int(data.Organization.ValueInt32())
 
-	url := "/api/v2/labels/"
+	url := r.client.APIPath("labels/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -127,7 +125,7 @@ func (r *LabelsResource) Read(ctx context.Context, req resource.ReadRequest, res
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/labels/%d/", id)
+	url := r.client.APIPath("labels/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -188,12 +186,10 @@ func (r *LabelsResource) Update(ctx context.Context, req resource.UpdateRequest,
 	bodyData.Name = data.Name.ValueString()
 	bodyData.Organization = int(data.Organization.ValueInt32())
 
-	url := fmt.Sprintf("/api/v2/labels/%d/", id)
+	url := r.client.APIPath("labels/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &membershipResource{}
+var _ resource.ResourceWithImportState = &membershipResource{}
+
+// membershipConfig describes a resource that manages the objects associated to a
+// related collection of a parent object, e.g. the users of teams/N/users/.
+type membershipConfig struct {
+	// typeName is appended to the provider type name, e.g. "_team_member".
+	typeName    string
+	description string
+
+	// parentAttribute holds the parent's id, e.g. "team_id". The resource is imported by it.
+	parentAttribute   string
+	parentDescription string
+	// relatedPath is the format of the related collection's path, e.g. "teams/%d/users/".
+	relatedPath string
+
+	// membersAttribute holds the set of associated ids, e.g. "user_ids".
+	membersAttribute   string
+	membersDescription string
+
+	// deprecationMessage, when set, deprecates the resource in favour of another.
+	deprecationMessage string
+	// additive makes authoritative default to false, for resources that were additive before
+	// the attribute existed.
+	additive bool
+	// parentInPlace moves the members to a new parent in place rather than replacing the resource.
+	parentInPlace bool
+}
+
+// defaultAuthoritative is the value of authoritative when it is not configured.
+func (c membershipConfig) defaultAuthoritative() bool {
+	return !c.additive
+}
+
+// membershipResource associates and disassociates the members of a related collection,
+// diffing the configured ids against the ids the collection currently holds.
+//
+// When authoritative, any member that is not configured is removed, so the collection
+// holds exactly the configured ids. Otherwise only the configured members are managed
+// and members added by other means are left alone.
+type membershipResource struct {
+	client *AwxClient
+	config membershipConfig
+}
+
+func (r *membershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.config.typeName
+}
+
+func (r *membershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var parentPlanModifiers []planmodifier.String
+	if !r.config.parentInPlace {
+		parentPlanModifiers = append(parentPlanModifiers, stringplanmodifier.RequiresReplace())
+	}
+
+	authoritativeDescription := "When true, the default, any member that is not listed is removed, including members added outside of terraform. " +
+		"When false, only the listed members are managed and any other member is left alone."
+	if r.config.additive {
+		authoritativeDescription = "When true, any member that is not listed is removed, including members added outside of terraform. " +
+			"When false, the default, only the listed members are managed and any other member is left alone."
+	}
+
+	resp.Schema = schema.Schema{
+		Description:        r.config.description,
+		DeprecationMessage: r.config.deprecationMessage,
+		Attributes: map[string]schema.Attribute{
+			r.config.parentAttribute: schema.StringAttribute{
+				Required:      true,
+				Description:   r.config.parentDescription,
+				PlanModifiers: parentPlanModifiers,
+			},
+			r.config.membersAttribute: schema.SetAttribute{
+				Required:    true,
+				Description: r.config.membersDescription,
+				ElementType: types.Int32Type,
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(r.config.defaultAuthoritative()),
+				Description: authoritativeDescription,
+			},
+		},
+	}
+}
+
+func (r *membershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+// membershipData is the state of a membership resource. The attribute names vary between
+// resources, so it is read and written attribute by attribute rather than with a model struct.
+type membershipData struct {
+	parentId      types.String
+	memberIds     []int
+	authoritative bool
+	// imported is set when the members are not known yet, after an import.
+	imported bool
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target any) diag.Diagnostics
+}
+
+// attributeSetter is implemented by tfsdk.State.
+type attributeSetter interface {
+	SetAttribute(ctx context.Context, path path.Path, val any) diag.Diagnostics
+}
+
+func (r *membershipResource) get(ctx context.Context, source attributeGetter, diags *diag.Diagnostics) (data membershipData) {
+	var members types.Set
+	var authoritative types.Bool
+
+	diags.Append(source.GetAttribute(ctx, path.Root(r.config.parentAttribute), &data.parentId)...)
+	diags.Append(source.GetAttribute(ctx, path.Root(r.config.membersAttribute), &members)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("authoritative"), &authoritative)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(members.ElementsAs(ctx, &data.memberIds, false)...)
+	data.imported = members.IsNull()
+	// a resource that was just imported has no authoritative value yet
+	if authoritative.IsNull() {
+		data.authoritative = r.config.defaultAuthoritative()
+	} else {
+		data.authoritative = authoritative.ValueBool()
+	}
+	return
+}
+
+func (r *membershipResource) set(ctx context.Context, state attributeSetter, data membershipData, diags *diag.Diagnostics) {
+	if data.memberIds == nil {
+		// an empty set rather than null, members is a required attribute
+		data.memberIds = []int{}
+	}
+
+	members, d := types.SetValueFrom(ctx, types.Int32Type, data.memberIds)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(r.config.parentAttribute), data.parentId)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.config.membersAttribute), members)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("authoritative"), data.authoritative)...)
+}
+
+// relatedURL returns the url of the parent's related collection.
+func (r *membershipResource) relatedURL(parentId types.String, diags *diag.Diagnostics) string {
+	id, err := strconv.Atoi(parentId.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert %s: %v.", r.config.parentAttribute, parentId.ValueString()))
+		return ""
+	}
+	return r.client.APIPath(r.config.relatedPath, id)
+}
+
+// syncRelated associates every id in add to the related collection at url and disassociates
+// every id in remove.
+func syncRelated(ctx context.Context, client *AwxClient, url string, add, remove []int, diags *diag.Diagnostics) {
+	for _, v := range remove {
+		bodyData := ChildDissasocBody{Id: v, Disassociate: true}
+
+		_, _, err := client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
+		if err != nil {
+			diags.AddError("Failed to disassociate child.", err.Error())
+			return
+		}
+	}
+
+	for _, v := range add {
+		bodyData := ChildResult{Id: v}
+
+		_, _, err := client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
+		if err != nil {
+			diags.AddError("Failed to associate child.", err.Error())
+			return
+		}
+	}
+}
+
+// membershipChanges returns the ids to associate and disassociate so a related collection
+// holding currentIds holds planIds. When not authoritative only the stateIds we associated
+// before are disassociated.
+func membershipChanges(currentIds, planIds, stateIds []int, authoritative bool) (add, remove []int) {
+	for _, v := range currentIds {
+		if !slices.Contains(planIds, v) && (authoritative || slices.Contains(stateIds, v)) {
+			remove = append(remove, v)
+		}
+	}
+	for _, v := range planIds {
+		if !slices.Contains(currentIds, v) {
+			add = append(add, v)
+		}
+	}
+	return
+}
+
+// membershipMembers returns the members to report of a related collection holding currentIds.
+func membershipMembers(currentIds, stateIds []int, authoritative bool) []int {
+	if authoritative {
+		return currentIds
+	}
+	// only the configured members that are still associated, others are not ours to report
+	return slices.DeleteFunc(stateIds, func(v int) bool {
+		return !slices.Contains(currentIds, v)
+	})
+}
+
+func (r *membershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := r.get(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.relatedURL(data.parentId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	add, remove := membershipChanges(currentIds, data.memberIds, nil, data.authoritative)
+	syncRelated(ctx, r.client, url, add, remove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+func (r *membershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := r.get(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.relatedURL(data.parentId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIds, statusCode, err := r.client.ListChildIds(ctx, url, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// an imported resource reports every member, as none are known to be ours yet
+	data.memberIds = membershipMembers(currentIds, data.memberIds, data.authoritative || data.imported)
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+func (r *membershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := r.get(ctx, req.Plan, &resp.Diagnostics)
+	state := r.get(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.parentId.Equal(state.parentId) {
+		r.removeMembers(ctx, state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// none of the new parent's members are ours yet
+		state.memberIds = nil
+	}
+
+	url := r.relatedURL(data.parentId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	add, remove := membershipChanges(currentIds, data.memberIds, state.memberIds, data.authoritative)
+	syncRelated(ctx, r.client, url, add, remove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+func (r *membershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := r.get(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.removeMembers(ctx, data, &resp.Diagnostics)
+}
+
+// removeMembers disassociates the members in data from its parent, whether authoritative or not.
+func (r *membershipResource) removeMembers(ctx context.Context, data membershipData, diags *diag.Diagnostics) {
+	url := r.relatedURL(data.parentId, diags)
+	if diags.HasError() {
+		return
+	}
+
+	currentIds, statusCode, err := r.client.ListChildIds(ctx, url, []int{200, 404})
+	if err != nil {
+		diags.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// the parent is already gone and its members with it
+	if statusCode == 404 {
+		return
+	}
+
+	_, remove := membershipChanges(currentIds, nil, data.memberIds, false)
+	syncRelated(ctx, r.client, url, nil, remove, diags)
+}
+
+func (r *membershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the %s to import, got: %q.", r.config.parentAttribute, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.config.parentAttribute), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), r.config.defaultAuthoritative())...)
+}
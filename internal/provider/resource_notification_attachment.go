@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NotificationAttachmentResource{}
+var _ resource.ResourceWithImportState = &NotificationAttachmentResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationAttachmentResource{}
+
+// notificationEventNames are the events notification templates are sent on.
+var notificationEventNames = []string{"started", "success", "error", "approvals"}
+
+// notificationParent is an object notification templates attach to.
+type notificationParent struct {
+	// collection is the parent's collection in the API, e.g. "job_templates".
+	collection string
+	events     []string
+}
+
+// notificationParents are the objects notification templates attach to, by parent_type.
+// Each event is the parent's related collection notification_templates_<event>.
+var notificationParents = map[string]notificationParent{
+	"inventory_source":      {collection: "inventory_sources", events: []string{"started", "success", "error"}},
+	"job_template":          {collection: "job_templates", events: []string{"started", "success", "error"}},
+	"organization":          {collection: "organizations", events: notificationEventNames},
+	"project":               {collection: "projects", events: []string{"started", "success", "error"}},
+	"workflow_job_template": {collection: "workflow_job_templates", events: notificationEventNames},
+}
+
+func NewNotificationAttachmentResource() resource.Resource {
+	return &NotificationAttachmentResource{}
+}
+
+type NotificationAttachmentResource struct {
+	client *AwxClient
+}
+
+func (r *NotificationAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_attachment"
+}
+
+func (r *NotificationAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach notification templates to an event of a job template, workflow job template, project, inventory source or organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The attachment's import ID, `<parent_type>/<parent_id>/<event>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the object the notification templates attach to. Options: `%s`.", strings.Join(sortedKeys(notificationParents), "`, `")),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortedKeys(notificationParents)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_id": schema.Int32Attribute{
+				Description: "ID of the object the notification templates attach to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"event": schema.StringAttribute{
+				Description: "The event the notification templates are sent on. Options: `started`, `success`, `error`, and `approvals` for workflow job templates and organizations.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationEventNames...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_template_ids": schema.SetAttribute{
+				Description: "The IDs of the `awx_notification_template`s sent on the event.",
+				Required:    true,
+				ElementType: types.Int32Type,
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "When true, the default, any notification template of the event that is not listed is removed, including those attached outside of terraform. " +
+					"When false, only the listed notification templates are managed and any other is left alone. " +
+					"Attach the notification templates of an event with a single authoritative resource: two resources managing the same event, " +
+					"including the deprecated `awx_job_template_notification_template_*` resources, would each remove what the other attaches.",
+			},
+		},
+	}
+}
+
+func (r NotificationAttachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationAttachmentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ParentType.IsUnknown() || data.Event.IsUnknown() {
+		return
+	}
+
+	parent, ok := notificationParents[data.ParentType.ValueString()]
+	if ok && !slices.Contains(parent.events, data.Event.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("event"),
+			"Unsupported notification event",
+			fmt.Sprintf("A %s has no %s notifications, its events are: %s.", data.ParentType.ValueString(), data.Event.ValueString(), strings.Join(parent.events, ", ")),
+		)
+	}
+}
+
+func (r *NotificationAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+// relatedURL returns the url of the related collection of the parent's event.
+func (r *NotificationAttachmentResource) relatedURL(data NotificationAttachmentModel) string {
+	parent := notificationParents[data.ParentType.ValueString()]
+	return r.client.APIPath("%s/%d/notification_templates_%s/", parent.collection, data.ParentId.ValueInt32(), data.Event.ValueString())
+}
+
+func (r *NotificationAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planIds []int
+	resp.Diagnostics.Append(data.NotificationTemplateIds.ElementsAs(ctx, &planIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.relatedURL(data)
+	currentIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	add, remove := membershipChanges(currentIds, planIds, nil, data.Authoritative.ValueBool())
+	syncRelated(ctx, r.client, url, add, remove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%d/%s", data.ParentType.ValueString(), data.ParentId.ValueInt32(), data.Event.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateIds []int
+	resp.Diagnostics.Append(data.NotificationTemplateIds.ElementsAs(ctx, &stateIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentIds, statusCode, err := r.client.ListChildIds(ctx, r.relatedURL(data), []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// a resource that was just imported has no authoritative value yet
+	authoritative := data.Authoritative.IsNull() || data.Authoritative.ValueBool()
+	data.Authoritative = types.BoolValue(authoritative)
+
+	ids, diags := types.SetValueFrom(ctx, types.Int32Type, membershipMembers(currentIds, stateIds, authoritative))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.NotificationTemplateIds = ids
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NotificationAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planIds, stateIds []int
+	resp.Diagnostics.Append(data.NotificationTemplateIds.ElementsAs(ctx, &planIds, false)...)
+	resp.Diagnostics.Append(state.NotificationTemplateIds.ElementsAs(ctx, &stateIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.relatedURL(data)
+	currentIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	add, remove := membershipChanges(currentIds, planIds, stateIds, data.Authoritative.ValueBool())
+	syncRelated(ctx, r.client, url, add, remove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateIds []int
+	resp.Diagnostics.Append(data.NotificationTemplateIds.ElementsAs(ctx, &stateIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.relatedURL(data)
+	currentIds, statusCode, err := r.client.ListChildIds(ctx, url, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// the parent is already gone and its notification templates with it
+	if statusCode == 404 {
+		return
+	}
+
+	_, remove := membershipChanges(currentIds, nil, stateIds, false)
+	syncRelated(ctx, r.client, url, nil, remove, &resp.Diagnostics)
+}
+
+func (r *NotificationAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	var parentId int
+	var err error
+	if len(parts) == 3 {
+		parentId, err = strconv.Atoi(parts[1])
+	}
+	parent, ok := notificationParents[parts[0]]
+	if len(parts) != 3 || err != nil || !ok || !slices.Contains(parent.events, parts[2]) {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected <parent_type>/<parent_id>/<event>, e.g. job_template/12/error, got: %q.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_id"), int32(parentId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
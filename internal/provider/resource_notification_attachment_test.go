@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitNotificationAttachmentResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	outsiderId := server.Add("notification_templates", awxmock.Object{"name": "outsider", "notification_type": "webhook"})
+	var jobTemplateId, workflowId int
+
+	config := func(jobTemplateIds, authoritative string) string {
+		// a page size of 1 so that every list of notification templates spans pages
+		return fmt.Sprintf(`
+provider "awx" {
+  endpoint  = %q
+  token     = "test"
+  page_size = 1
+}
+`, server.URL) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_notification_template" "test" {
+  count             = 3
+  name              = "test-${count.index}"
+  organization      = awx_organization.test.id
+  notification_type = "webhook"
+  webhook = {
+    url = "https://hooks.example.com/awx"
+  }
+}
+
+resource "awx_notification_attachment" "job_template" {
+  parent_type               = "job_template"
+  parent_id                 = awx_job_template.test.id
+  event                     = "error"
+  notification_template_ids = ` + jobTemplateIds + `
+  authoritative             = ` + authoritative + `
+}
+
+resource "awx_notification_attachment" "approvals" {
+  parent_type               = "workflow_job_template"
+  parent_id                 = awx_workflow_job_template.test.id
+  event                     = "approvals"
+  notification_template_ids = [awx_notification_template.test[2].id]
+}
+
+resource "awx_notification_attachment" "organization" {
+  parent_type               = "organization"
+  parent_id                 = awx_organization.test.id
+  event                     = "started"
+  notification_template_ids = awx_notification_template.test[*].id
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_notification_template.test[*].id", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("awx_notification_attachment.job_template", "id", regexp.MustCompile(`^job_template/\d+/error$`)),
+					resource.TestCheckResourceAttr("awx_notification_attachment.job_template", "notification_template_ids.#", "3"),
+					testCheckRelatedCount(server, "awx_notification_attachment.job_template", "parent_id", "job_templates", "notification_templates_error", 3),
+					testCheckRelatedCount(server, "awx_notification_attachment.approvals", "parent_id", "workflow_job_templates", "notification_templates_approvals", 1),
+					testCheckRelatedCount(server, "awx_notification_attachment.organization", "parent_id", "organizations", "notification_templates_started", 3),
+					func(s *terraform.State) (err error) {
+						jobTemplateId, err = strconv.Atoi(s.RootModule().Resources["awx_job_template.test"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						workflowId, err = strconv.Atoi(s.RootModule().Resources["awx_workflow_job_template.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:      "awx_notification_attachment.job_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "awx_notification_attachment.approvals",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("[awx_notification_template.test[1].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_attachment.job_template", "notification_template_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("awx_notification_attachment.job_template", "notification_template_ids.0", "awx_notification_template.test.1", "id"),
+					testCheckRelatedCount(server, "awx_notification_attachment.job_template", "parent_id", "job_templates", "notification_templates_error", 1),
+				),
+			},
+			{
+				// a notification template attached outside of terraform is drift when authoritative
+				PreConfig: func() {
+					server.Associate("workflow_job_templates", workflowId, "notification_templates_approvals", outsiderId)
+				},
+				Config:             config("[awx_notification_template.test[1].id]", "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// the apply removes it again
+				Config: config("[awx_notification_template.test[1].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_attachment.approvals", "notification_template_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_notification_attachment.approvals", "parent_id", "workflow_job_templates", "notification_templates_approvals", 1),
+				),
+			},
+			{
+				Config: config("[awx_notification_template.test[1].id]", "false"),
+				Check:  resource.TestCheckResourceAttr("awx_notification_attachment.job_template", "authoritative", "false"),
+			},
+			{
+				// additive attachments leave others alone
+				PreConfig: func() {
+					server.Associate("job_templates", jobTemplateId, "notification_templates_error", outsiderId)
+				},
+				Config: config("[awx_notification_template.test[0].id]", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_attachment.job_template", "notification_template_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_notification_attachment.job_template", "parent_id", "job_templates", "notification_templates_error", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitNotificationAttachmentResourceParentDeleted(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	sourceId := server.Add("inventory_sources", awxmock.Object{"name": "test"})
+	templateId := server.Add("notification_templates", awxmock.Object{"name": "test", "notification_type": "webhook"})
+
+	config := testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_notification_attachment" "test" {
+  parent_type               = "inventory_source"
+  parent_id                 = %d
+  event                     = "success"
+  notification_template_ids = [%d]
+}
+`, sourceId, templateId)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testCheckRelatedCount(server, "awx_notification_attachment.test", "parent_id", "inventory_sources", "notification_templates_success", 1),
+			},
+			{
+				// an attachment whose parent is deleted outside of terraform is planned again
+				PreConfig: func() {
+					server.Delete("inventory_sources", sourceId)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitNotificationAttachmentResourceValidation(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_attachment" "test" {
+  parent_type               = "project"
+  parent_id                 = 1
+  event                     = "approvals"
+  notification_template_ids = [1]
+}
+`,
+				ExpectError: regexp.MustCompile(`A project has no approvals notifications`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_attachment" "test" {
+  parent_type               = "project"
+  parent_id                 = 1
+  event                     = "started"
+  notification_template_ids = [1]
+}
+`,
+				ResourceName:  "awx_notification_attachment.test",
+				ImportState:   true,
+				ImportStateId: "project/1/approvals",
+				ExpectError:   regexp.MustCompile(`Expected <parent_type>/<parent_id>/<event>`),
+			},
+		},
+	})
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,10 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 var _ resource.Resource = &NotificationTemplatesResource{}
 var _ resource.ResourceWithImportState = &NotificationTemplatesResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationTemplatesResource{}
+var _ resource.ResourceWithUpgradeState = &NotificationTemplatesResource{}
 
 func NewNotificationTemplatesResource() resource.Resource {
 	return &NotificationTemplatesResource{}
@@ -36,7 +41,34 @@ type NotificationTemplatesResourceModel struct {
 	Organization              types.Int32  `tfsdk:"organization"`
 	NotificationType          types.String `tfsdk:"notification_type"`
 	NotificationConfiguration types.String `tfsdk:"notification_configuration"`
-	Messages                  types.String `tfsdk:"messages"`
+	Messages                  types.Object `tfsdk:"messages"`
+
+	Awssns     types.Object `tfsdk:"awssns"`
+	Email      types.Object `tfsdk:"email"`
+	Grafana    types.Object `tfsdk:"grafana"`
+	Irc        types.Object `tfsdk:"irc"`
+	Mattermost types.Object `tfsdk:"mattermost"`
+	Pagerduty  types.Object `tfsdk:"pagerduty"`
+	Rocketchat types.Object `tfsdk:"rocketchat"`
+	Slack      types.Object `tfsdk:"slack"`
+	Twilio     types.Object `tfsdk:"twilio"`
+	Webhook    types.Object `tfsdk:"webhook"`
+}
+
+// configurations returns the typed configuration of each notification type, by type.
+func (m *NotificationTemplatesResourceModel) configurations() map[string]*types.Object {
+	return map[string]*types.Object{
+		"awssns":     &m.Awssns,
+		"email":      &m.Email,
+		"grafana":    &m.Grafana,
+		"irc":        &m.Irc,
+		"mattermost": &m.Mattermost,
+		"pagerduty":  &m.Pagerduty,
+		"rocketchat": &m.Rocketchat,
+		"slack":      &m.Slack,
+		"twilio":     &m.Twilio,
+		"webhook":    &m.Webhook,
+	}
 }
 
 type NotificationTemplateAPI struct {
@@ -46,25 +78,8 @@ type NotificationTemplateAPI struct {
 	Organization              int    `json:"organization"`
 	NotificationType          string `json:"notification_type"`
 	NotificationConfiguration any    `json:"notification_configuration,omitempty"`
-	Messages                  any    `json:"messages,omitempty"`
-}
-
-type SlackConfiguration struct {
-	Channels  []string `json:"channels"`
-	HexColors string   `json:"hex_color"`
-	Token     string   `json:"token"`
-}
 
-type MessageValue struct {
-	Body    string `json:"body"`
-	Message string `json:"message"`
-}
-
-type Messages struct {
-	Error            MessageValue            `json:"error"`
-	Started          MessageValue            `json:"started"`
-	Success          MessageValue            `json:"success"`
-	WorkflowApproval map[string]MessageValue `json:"workflow_approval"`
+	Messages *notificationMessagesAPIModel `json:"messages"`
 }
 
 func (r *NotificationTemplatesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,49 +87,160 @@ func (r *NotificationTemplatesResource) Metadata(ctx context.Context, req resour
 }
 
 func (r *NotificationTemplatesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Manage a notification template. These can be attached, by ID, to job templates, as an example usage.",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "Notification template name.",
-			},
-			"description": schema.StringAttribute{
-				Optional:    true,
-				Default:     stringdefault.StaticString(""),
-				Computed:    true,
-				Description: "Defaults to `\"\"`",
-			},
-			"organization": schema.Int32Attribute{
-				Required:    true,
-				Description: "Organization ID for the notification template.",
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"notification_type": schema.StringAttribute{
-				Required:    true,
-				Description: "Only `slack` is supported in this provider currently. Choose from: `email`, `grafan`, `irc`, `mattermost`, `pagerduty`, `rocketchat`, `slack`, `twilio`, `webhook`.",
-				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"slack"}...),
-				},
-			},
-			"notification_configuration": schema.StringAttribute{
-				Optional:    true,
-				Description: "json. This value depends on the `notification_type` chosen. But, the value should be json. E.g. `notification_configuration = jsonencode(blah blah blah)`. The AWX Tower API never returns a value for Token. So, this provider is coded to ignore changes to that field.",
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Notification template name.",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Default:     stringdefault.StaticString(""),
+			Computed:    true,
+			Description: "Defaults to `\"\"`",
+		},
+		"organization": schema.Int32Attribute{
+			Required:    true,
+			Description: "Organization ID for the notification template.",
+		},
+		"notification_type": schema.StringAttribute{
+			Required: true,
+			Description: fmt.Sprintf("The notification type, one of: `%s`. Configure it with the attribute of the same name, e.g. `slack`.",
+				strings.Join(notificationTypeNames(), "`, `")),
+			Validators: []validator.String{
+				stringvalidator.OneOf(notificationTypeNames()...),
 			},
-			"messages": schema.StringAttribute{
-				Optional:    true,
-				Description: "json",
+		},
+		"notification_configuration": schema.StringAttribute{
+			Optional: true,
+			Description: "json. This value depends on the `notification_type` chosen. But, the value should be json. E.g. `notification_configuration = jsonencode(blah blah blah)`. " +
+				"The AWX Tower API never returns secrets, e.g. the slack token. So, this provider is coded to ignore changes to those fields.",
+			DeprecationMessage: "Use the attribute of the notification type instead, e.g. `slack`.",
+		},
+		"messages": notificationMessagesAttribute(),
+	}
+	for _, notificationType := range notificationTypeNames() {
+		attributes[notificationType] = notificationConfigurationAttribute(notificationType)
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a notification template. These can be attached, by ID, to job templates, as an example usage. " +
+			"Secrets such as passwords and tokens are never returned by AWX, so changes made to them outside of terraform are not detected.",
+		Version:    1,
+		Attributes: attributes,
+	}
+}
+
+func (r *NotificationTemplatesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// messages was a json string
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state map[string]any
+				err := json.Unmarshal(req.RawState.JSON, &state)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade notification template state",
+						fmt.Sprintf("Error = %s ", err.Error()))
+					return
+				}
+
+				if messages, ok := state["messages"].(string); ok {
+					state["messages"], err = upgradeNotificationMessagesV0(messages)
+					if err != nil {
+						resp.Diagnostics.AddError(
+							"Unable to upgrade notification template state",
+							fmt.Sprintf("Unable to unmarshal messages. Error = %s ", err.Error()))
+						return
+					}
+				}
+
+				upgraded, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade notification template state",
+						fmt.Sprintf("Error = %s ", err.Error()))
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
 			},
 		},
 	}
 }
 
+func (r NotificationTemplatesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationTemplatesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NotificationType.IsUnknown() || data.NotificationType.IsNull() {
+		return
+	}
+	notificationType := data.NotificationType.ValueString()
+
+	for name, config := range data.configurations() {
+		if name != notificationType && !config.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Attribute Configuration Error",
+				fmt.Sprintf("%s is set but notification_type is %s, only the configuration of the notification type may be set.", name, notificationType),
+			)
+		}
+	}
+
+	config, ok := data.configurations()[notificationType]
+	if !ok {
+		return
+	}
+
+	if !config.IsNull() && !data.NotificationConfiguration.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_configuration"),
+			"Attribute Configuration Error",
+			fmt.Sprintf("notification_configuration cannot be set along with %s.", notificationType),
+		)
+	}
+	if config.IsNull() && data.NotificationConfiguration.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(notificationType),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("A %s notification requires %s to be set.", notificationType, notificationType),
+		)
+	}
+}
+
+// requestConfiguration returns the notification_configuration to send, from the typed
+// configuration of the notification type or the deprecated json notification_configuration.
+func (r *NotificationTemplatesResource) requestConfiguration(ctx context.Context, data NotificationTemplatesResourceModel) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.NotificationConfiguration.IsNull() {
+		var config map[string]any
+
+		err := json.Unmarshal([]byte(data.NotificationConfiguration.ValueString()), &config)
+		if err != nil {
+			diags.AddError(
+				"Unable to move Notification Config into json object",
+				fmt.Sprintf("Error = %s ", err.Error()))
+		}
+		return config, diags
+	}
+
+	if config, ok := data.configurations()[data.NotificationType.ValueString()]; ok && !config.IsNull() {
+		return notificationConfigurationToAPI(ctx, data.NotificationType.ValueString(), *config)
+	}
+
+	return nil, diags
+}
+
 func (r *NotificationTemplatesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -149,43 +275,23 @@ func (r *NotificationTemplatesResource) Create(ctx context.Context, req resource
 	bodyData.Organization = int(data.Organization.ValueInt32())
 	bodyData.NotificationType = data.NotificationType.ValueString()
 
-	if !data.NotificationConfiguration.IsNull() {
-		fieldToBytes := []byte(data.NotificationConfiguration.ValueString())
-
-		slackConfig := new(SlackConfiguration)
-
-		err := json.Unmarshal(fieldToBytes, &slackConfig)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to move Notification Config into json object",
-				fmt.Sprintf("Error = %s ", err.Error()))
-			return
-		}
-
-		bodyData.NotificationConfiguration = slackConfig
+	config, diags := r.requestConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Messages.IsNull() {
-		fieldToBytes := []byte(data.Messages.ValueString())
+	bodyData.NotificationConfiguration = config
 
-		messageData := new(Messages)
-
-		err := json.Unmarshal(fieldToBytes, &messageData)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to move Messages into json object",
-				fmt.Sprintf("Error = %s ", err.Error()))
-			return
-		}
-
-		bodyData.Messages = messageData
+	bodyData.Messages, diags = notificationMessagesToAPI(ctx, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	url := "/api/v2/notification_templates/"
+	url := r.client.APIPath("notification_templates/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -221,7 +327,7 @@ func (r *NotificationTemplatesResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/notification_templates/%d/", id)
+	url := r.client.APIPath("notification_templates/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -270,253 +376,69 @@ func (r *NotificationTemplatesResource) Read(ctx context.Context, req resource.R
 		}
 	}
 
-	if !(data.NotificationType.IsNull() && responseData.NotificationType == "") {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_type"), responseData.NotificationType)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	// Get the State's Token value provide that back into the State.
-	// The api will always return a blank value for token on GET, I'm assuming as a safety measure.
-	var stateNotifConfig types.String
-	diags := req.State.GetAttribute(ctx, path.Root("notification_configuration"), &stateNotifConfig)
-	if diags.HasError() {
-		return
-	}
-
-	useResponseConfig := false
-	var stateToken string
+	responseConfig, _ := responseData.NotificationConfiguration.(map[string]any)
 
-	if stateNotifConfig.IsNull() {
-		useResponseConfig = true
-	} else {
-		stateSlackConfig := new(SlackConfiguration)
+	// The api never returns secrets, e.g. the slack token, on GET. So their values are taken from state.
+	if !data.NotificationConfiguration.IsNull() {
+		// the deprecated json notification_configuration, kept to the fields it was written with
+		var stateConfig map[string]any
 
-		err = json.Unmarshal([]byte(stateNotifConfig.ValueString()), &stateSlackConfig)
+		err = json.Unmarshal([]byte(data.NotificationConfiguration.ValueString()), &stateConfig)
 		if err != nil {
 			resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-				"Unable to unmarshal plan's notification configuration into a go type for interogation."+err.Error(),
+				"Unable to unmarshal state's notification configuration into a go type for interogation."+err.Error(),
 			)
 			return
 		}
 
-		stateToken = stateSlackConfig.Token
-	}
-
-	// now build the state from the API response data for notification configuration (slack)
-	slackConfig := new(SlackConfiguration)
-
-	notificationConfig, ok := responseData.NotificationConfiguration.(map[string]any)
-	if !ok {
-		resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-			"Unexpected error in resource_notification_templates with responseData.NotificationConfiguration",
-		)
-		return
-	}
-
-	for k, v := range notificationConfig {
-		if k == "hex_color" {
-			if hexColor, ok := v.(string); ok {
-				slackConfig.HexColors = hexColor
-			} else {
-				resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-					"Unexpected error in esource_notification_templates with. hex_color is not a string",
-				)
-				return
+		secrets := notificationSecretKeys(responseData.NotificationType)
+		config := make(map[string]any, len(stateConfig))
+		for key, stateValue := range stateConfig {
+			if secrets[key] {
+				config[key] = stateValue
+			} else if value, ok := responseConfig[key]; ok {
+				config[key] = value
 			}
 		}
-		if k == "token" {
-			if useResponseConfig {
-				if respToken, ok := v.(string); ok {
-					slackConfig.Token = respToken
-				} else {
-					resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-						"Unexpected error in esource_notification_templates with. token is not a string",
-					)
-					return
-				}
-			} else {
-				slackConfig.Token = stateToken
-			}
-		}
-		if k == "channels" {
-			if channels, ok := v.([]any); ok {
-				channelList := make([]string, 0, len(channels))
-				for _, ch := range channels {
-					if channel, ok := ch.(string); ok {
-						channelList = append(channelList, channel)
-					} else {
-						resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-							"Unexpected error in esource_notification_templates with. channel is not the right type.",
-						)
-						return
-					}
-				}
-				slackConfig.Channels = channelList
-			} else {
-				resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-					"Unexpected error in esource_notification_templates with. channels is not the right type.",
-				)
-				return
-			}
-		}
-	}
 
-	config, err := json.Marshal(slackConfig)
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to move Notification Config into json object",
-			fmt.Sprintf("Error = %s ", err.Error()))
-		return
-	}
-
-	if !(data.NotificationConfiguration.IsNull() && responseData.NotificationConfiguration == nil) {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_configuration"), string(config))...)
-		if resp.Diagnostics.HasError() {
+		configJson, err := json.Marshal(config)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to move Notification Config into json object",
+				fmt.Sprintf("Error = %s ", err.Error()))
 			return
 		}
-	}
-
-	messages := new(Messages)
-
-	if responseData.Messages != nil {
 
-		foundOneMessageVal := false
-		resp_msgs, ok := responseData.Messages.(map[string]any)
-		if !ok {
-			resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-				"Unexpected error in resource_notification_templates. resp_msgs not the right type",
-			)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_configuration"), string(configJson))...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		for k, v := range resp_msgs {
-			if v == nil {
-				continue
-			} else {
-				foundOneMessageVal = true
-			}
-			if k == "error" {
-
-				if errorMsg, ok := v.(map[string]any); ok {
-					if msg_body, ok := errorMsg["body"].(string); ok {
-						if msg_message, ok := errorMsg["message"].(string); ok {
-							messages.Error = MessageValue{Body: msg_body, Message: msg_message}
-						} else {
-							resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-								"Unexpected error in esource_notification_templates with. error msg_message is not the right type",
-							)
-						}
-					} else {
-						resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-							"Unexpected error in esource_notification_templates with. error msg_body is not the right type",
-						)
-					}
-				} else {
-					resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-						"Unexpected error in esource_notification_templates with. error msg is not the right type",
-					)
-					return
-				}
-
-			}
-			if k == "started" {
-				if startMsg, ok := v.(map[string]any); ok {
-					if msg_body, ok := startMsg["body"].(string); ok {
-						if msg_message, ok := startMsg["message"].(string); ok {
-							messages.Started = MessageValue{Body: msg_body, Message: msg_message}
-						} else {
-							resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-								"Unexpected error in esource_notification_templates with. started msg_message is not the right type",
-							)
-						}
-					} else {
-						resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-							"Unexpected error in esource_notification_templates with. started msg_body is not the right type",
-						)
-					}
-				} else {
-					resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-						"Unexpected error in esource_notification_templates with. started msg is not the right type",
-					)
-					return
-				}
-			}
-			if k == "success" {
-				if successMsg, ok := v.(map[string]any); ok {
-					if msg_body, ok := successMsg["body"].(string); ok {
-						if msg_message, ok := successMsg["message"].(string); ok {
-							messages.Success = MessageValue{Body: msg_body, Message: msg_message}
-						} else {
-							resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-								"Unexpected error in esource_notification_templates with. started msg_message is not the right type",
-							)
-						}
-					} else {
-						resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-							"Unexpected error in esource_notification_templates with. started msg_body is not the right type",
-						)
-					}
-				} else {
-					resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-						"Unexpected error in esource_notification_templates with. started msg is not the right type",
-					)
+	} else {
+		for notificationType, stateConfig := range data.configurations() {
+			config := types.ObjectNull(notificationAttrTypes(notificationType))
+			if notificationType == responseData.NotificationType {
+				var diags diag.Diagnostics
+				config, diags = notificationConfigurationFromAPI(ctx, notificationType, responseConfig, *stateConfig)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
 					return
 				}
 			}
-			if k == "workflow_approval" {
-				if wkaParentValue, ok := v.(map[string]any); ok {
-					wkaParent := wkaParentValue
-					wka := make(map[string]MessageValue, len(wkaParent))
-					for key, val := range wkaParent {
-
-						if valMsg, ok := val.(map[string]any); ok {
-							if msg_body, ok := valMsg["body"].(string); ok {
-								if msg_message, ok := valMsg["message"].(string); ok {
-									wka[key] = MessageValue{Body: msg_body, Message: msg_message}
-								} else {
-									resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-										"Unexpected error in esource_notification_templates with. val msg_message is not the right type",
-									)
-								}
-							} else {
-								resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-									"Unexpected error in esource_notification_templates with. val msg_body is not the right type",
-								)
-							}
-						} else {
-							resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-								"Unexpected error in esource_notification_templates with. val msg is not the right type",
-							)
-							return
-						}
-
-					}
-					messages.WorkflowApproval = wka
-				} else {
-					resp.Diagnostics.AddError("Unexpected error in resource_notification_templates",
-						"Unexpected error in resource_notification_templates. wkaParent is not the right type",
-					)
-				}
-			}
-		}
 
-		msgJson, err := json.Marshal(messages)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to move Messages into json object",
-				fmt.Sprintf("Error = %s ", err.Error()))
-			return
-		}
-
-		if !(data.Messages.IsNull() && responseData.Messages == nil) && foundOneMessageVal {
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("messages"), string(msgJson))...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(notificationType), config)...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
 		}
 	}
+
+	messages, diags := notificationMessagesFromAPI(ctx, responseData.Messages, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("messages"), messages)...)
 }
 
 func (r *NotificationTemplatesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -546,40 +468,23 @@ func (r *NotificationTemplatesResource) Update(ctx context.Context, req resource
 	bodyData.Organization = int(data.Organization.ValueInt32())
 	bodyData.NotificationType = data.NotificationType.ValueString()
 
-	fieldToBytes := []byte(data.NotificationConfiguration.ValueString())
-
-	slackConfig := new(SlackConfiguration)
-
-	err = json.Unmarshal(fieldToBytes, &slackConfig)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to move Notification Config into json object",
-			fmt.Sprintf("Error = %s ", err.Error()))
+	config, diags := r.requestConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	bodyData.NotificationConfiguration = config
 
-	bodyData.NotificationConfiguration = slackConfig
-
-	fieldToBytes = []byte(data.Messages.ValueString())
-
-	messageData := new(Messages)
-
-	err = json.Unmarshal(fieldToBytes, &messageData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to move Messages into json object",
-			fmt.Sprintf("Error = %s ", err.Error()))
+	bodyData.Messages, diags = notificationMessagesToAPI(ctx, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	bodyData.Messages = messageData
-
-	url := fmt.Sprintf("/api/v2/notification_templates/%d/", id)
+	url := r.client.APIPath("notification_templates/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -602,7 +507,7 @@ func (r *NotificationTemplatesResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/notification_templates/%d/", id)
+	url := r.client.APIPath("notification_templates/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
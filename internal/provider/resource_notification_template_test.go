@@ -0,0 +1,586 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitNotificationTemplatesResourceJsonConfiguration(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_notification_template" "test" {
+  name              = "test"
+  description       = "test notification"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    channels  = ["#alerts"]
+    hex_color = ""
+    token     = "secret"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_notification_template.test", "id"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "notification_type", "slack"),
+				),
+			},
+			{
+				ResourceName:      "awx_notification_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// an imported template gets the typed configuration rather than the deprecated json one
+				ImportStateVerifyIgnore: []string{"notification_configuration", "slack"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_notification_template" "test" {
+  name              = "renamed"
+  description       = "renamed notification"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    channels  = ["#alerts", "#ops"]
+    hex_color = ""
+    token     = "secret"
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_template.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "description", "renamed notification"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitNotificationTemplatesResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var templateId int
+
+	config := func(channels, hexColor string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = awx_organization.test.id
+  notification_type = "slack"
+  slack = {
+    channels  = ` + channels + `
+    token     = "secret"
+    hex_color = "` + hexColor + `"
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["#alerts"]`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.channels.#", "1"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.channels.0", "#alerts"),
+					// the token is masked by the api, it is kept from state
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.token", "secret"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "email"),
+					func(s *terraform.State) (err error) {
+						templateId, err = strconv.Atoi(s.RootModule().Resources["awx_notification_template.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:            "awx_notification_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"slack.token"},
+			},
+			{
+				Config: config(`["#alerts", "#ops"]`, "#3af"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.channels.#", "2"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.hex_color", "#3af"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "slack.token", "secret"),
+					func(s *terraform.State) error {
+						obj, _ := server.Get("notification_templates", templateId)
+						if token := obj["notification_configuration"].(map[string]any)["token"]; token != "secret" {
+							return fmt.Errorf("expected the token to be sent, got %v", token)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// a configuration changed outside of terraform is drift
+				PreConfig: func() {
+					server.Update("notification_templates", templateId, awxmock.Object{
+						"notification_configuration": awxmock.Object{"channels": []string{"#alerts", "#ops"}, "hex_color": "#fff", "token": "secret"},
+					})
+				},
+				Config:             config(`["#alerts", "#ops"]`, "#3af"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitNotificationTemplatesResourceTypes(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_notification_template" "awssns" {
+  name              = "awssns"
+  organization      = awx_organization.test.id
+  notification_type = "awssns"
+  awssns = {
+    aws_region            = "eu-west-1"
+    aws_secret_access_key = "secret"
+    sns_topic_arn         = "arn:aws:sns:eu-west-1:123456789012:alerts"
+  }
+}
+
+resource "awx_notification_template" "email" {
+  name              = "email"
+  organization      = awx_organization.test.id
+  notification_type = "email"
+  email = {
+    host       = "smtp.example.com"
+    port       = 587
+    username   = "awx"
+    password   = "secret"
+    use_tls    = true
+    sender     = "awx@example.com"
+    recipients = ["ops@example.com"]
+  }
+}
+
+resource "awx_notification_template" "grafana" {
+  name              = "grafana"
+  organization      = awx_organization.test.id
+  notification_type = "grafana"
+  grafana = {
+    grafana_url     = "https://grafana.example.com"
+    grafana_key     = "secret"
+    dashboard_id    = 12
+    annotation_tags = ["awx"]
+  }
+}
+
+resource "awx_notification_template" "irc" {
+  name              = "irc"
+  organization      = awx_organization.test.id
+  notification_type = "irc"
+  irc = {
+    server   = "irc.example.com"
+    port     = 6697
+    nickname = "awx"
+    use_ssl  = true
+    targets  = ["#ops"]
+  }
+}
+
+resource "awx_notification_template" "mattermost" {
+  name              = "mattermost"
+  organization      = awx_organization.test.id
+  notification_type = "mattermost"
+  mattermost = {
+    mattermost_url     = "https://mattermost.example.com/hooks/abc"
+    mattermost_channel = "ops"
+  }
+}
+
+resource "awx_notification_template" "pagerduty" {
+  name              = "pagerduty"
+  organization      = awx_organization.test.id
+  notification_type = "pagerduty"
+  pagerduty = {
+    token       = "secret"
+    subdomain   = "example"
+    service_key = "secret-key"
+    client_name = "awx"
+  }
+}
+
+resource "awx_notification_template" "rocketchat" {
+  name              = "rocketchat"
+  organization      = awx_organization.test.id
+  notification_type = "rocketchat"
+  rocketchat = {
+    rocketchat_url = "https://rocketchat.example.com/hooks/abc"
+  }
+}
+
+resource "awx_notification_template" "twilio" {
+  name              = "twilio"
+  organization      = awx_organization.test.id
+  notification_type = "twilio"
+  twilio = {
+    account_sid   = "AC123"
+    account_token = "secret"
+    from_number   = "+15551234567"
+    to_numbers    = ["+15557654321"]
+  }
+}
+
+resource "awx_notification_template" "webhook" {
+  name              = "webhook"
+  organization      = awx_organization.test.id
+  notification_type = "webhook"
+  webhook = {
+    url         = "https://hooks.example.com/awx"
+    http_method = "PUT"
+    headers = {
+      X-Api-Key = "abc"
+    }
+    password = "secret"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_template.awssns", "awssns.aws_secret_access_key", "secret"),
+					resource.TestCheckResourceAttr("awx_notification_template.awssns", "awssns.aws_access_key_id", ""),
+					resource.TestCheckResourceAttr("awx_notification_template.email", "email.password", "secret"),
+					resource.TestCheckResourceAttr("awx_notification_template.email", "email.timeout", "30"),
+					resource.TestCheckResourceAttr("awx_notification_template.grafana", "grafana.dashboard_id", "12"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.grafana", "grafana.panel_id"),
+					resource.TestCheckResourceAttr("awx_notification_template.grafana", "grafana.is_region", "true"),
+					resource.TestCheckResourceAttr("awx_notification_template.irc", "irc.password", ""),
+					resource.TestCheckResourceAttr("awx_notification_template.mattermost", "mattermost.mattermost_no_verify_ssl", "false"),
+					resource.TestCheckResourceAttr("awx_notification_template.pagerduty", "pagerduty.service_key", "secret-key"),
+					resource.TestCheckResourceAttr("awx_notification_template.rocketchat", "rocketchat.rocketchat_username", ""),
+					resource.TestCheckResourceAttr("awx_notification_template.twilio", "twilio.account_token", "secret"),
+					resource.TestCheckResourceAttr("awx_notification_template.webhook", "webhook.headers.X-Api-Key", "abc"),
+					testCheckNotificationConfiguration(server, "awx_notification_template.grafana", "dashboardId", float64(12)),
+					testCheckNotificationConfiguration(server, "awx_notification_template.grafana", "isRegion", true),
+					testCheckNotificationConfiguration(server, "awx_notification_template.email", "port", float64(587)),
+					testCheckNotificationConfiguration(server, "awx_notification_template.webhook", "http_method", "PUT"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitNotificationTemplatesResourceMessages(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	config := func(messages string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = awx_organization.test.id
+  notification_type = "webhook"
+  webhook = {
+    url = "https://hooks.example.com/awx"
+  }
+` + messages + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  messages = {
+    started = {
+      message = "{{ job_friendly_name }} #{{ job.id }} started"
+    }
+    workflow_approval = {
+      running = {
+        message = "{{ approval_node_name }} needs review"
+        body    = "{{ job_metadata }}"
+      }
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_notification_template.test", "messages.started.message", "{{ job_friendly_name }} #{{ job.id }} started"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages.started.body"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages.error"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "messages.workflow_approval.running.body", "{{ job_metadata }}"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages.workflow_approval.denied"),
+					testCheckNotificationMessages(server, "awx_notification_template.test", func(messages map[string]any) error {
+						if messages["error"] != nil {
+							return fmt.Errorf("expected no error message to be sent, got %v", messages["error"])
+						}
+						running := messages["workflow_approval"].(map[string]any)["running"].(map[string]any)
+						if running["message"] != "{{ approval_node_name }} needs review" {
+							return fmt.Errorf("expected the running message to be sent, got %v", running)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "awx_notification_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`
+  messages = {
+    error = {
+      message = "{{ job.name }} failed"
+      body    = "{% for host, count in job.host_status_counts.items() %}{{ host }}: {{ count }}\n{% endfor %}"
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages.started"),
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages.workflow_approval"),
+					resource.TestCheckResourceAttr("awx_notification_template.test", "messages.error.message", "{{ job.name }} failed"),
+					testCheckNotificationMessages(server, "awx_notification_template.test", func(messages map[string]any) error {
+						if messages["started"] != nil || messages["workflow_approval"] != nil {
+							return fmt.Errorf("expected the started and approval messages to be removed, got %v", messages)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("awx_notification_template.test", "messages"),
+					testCheckNotificationMessages(server, "awx_notification_template.test", func(messages map[string]any) error {
+						if messages != nil {
+							return fmt.Errorf("expected the messages to be removed, got %v", messages)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestNotificationTemplatesResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &NotificationTemplatesResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := `{
+  "id": "1",
+  "name": "test",
+  "description": "",
+  "organization": 1,
+  "notification_type": "slack",
+  "notification_configuration": "{\"channels\":[\"#alerts\"],\"token\":\"secret\"}",
+  "messages": "{\"error\":{\"body\":\"\",\"message\":\"\"},\"started\":{\"body\":\"\",\"message\":\"{{ job.name }} started\"},\"success\":{\"body\":\"\",\"message\":\"\"},\"workflow_approval\":{\"approved\":{\"body\":\"\",\"message\":\"\"},\"denied\":{\"body\":\"{{ workflow_url }}\",\"message\":\"denied\"},\"running\":{\"body\":\"\",\"message\":\"\"},\"timed_out\":{\"body\":\"\",\"message\":\"\"}}}"
+}`
+
+	req := fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(state)}}
+	var resp fwresource.UpgradeStateResponse
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	// the upgraded state must decode with the current schema
+	value, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unable to decode the upgraded state: %s", err)
+	}
+	upgraded := tfsdk.State{Schema: schemaResp.Schema, Raw: value}
+
+	var messages types.Object
+	if diags := upgraded.GetAttribute(ctx, path.Root("messages"), &messages); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	var model notificationMessagesModel
+	if diags := messages.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if model.Started == nil || model.Started.Message.ValueString() != "{{ job.name }} started" || !model.Started.Body.IsNull() {
+		t.Errorf("expected the started message without a body, got %v", model.Started)
+	}
+	if model.Error != nil || model.Success != nil {
+		t.Errorf("expected the blank error and success messages to be null, got %v and %v", model.Error, model.Success)
+	}
+	if model.WorkflowApproval == nil || model.WorkflowApproval.Denied == nil || model.WorkflowApproval.Denied.Body.ValueString() != "{{ workflow_url }}" {
+		t.Errorf("expected the denied approval message, got %v", model.WorkflowApproval)
+	}
+	if model.WorkflowApproval != nil && model.WorkflowApproval.Approved != nil {
+		t.Errorf("expected the blank approved message to be null, got %v", model.WorkflowApproval.Approved)
+	}
+}
+
+func TestUnitNotificationTemplatesResourceValidation(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = 1
+  notification_type = "slack"
+  email = {
+    host       = "smtp.example.com"
+    port       = 25
+    sender     = "awx@example.com"
+    recipients = ["ops@example.com"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`email is set but notification_type is slack`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = 1
+  notification_type = "pagerduty"
+}
+`,
+				ExpectError: regexp.MustCompile(`A pagerduty notification requires pagerduty to be set`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = 1
+  notification_type = "webhook"
+  webhook = {
+    url = "https://hooks.example.com/awx"
+  }
+  messages = {
+    success = {
+      message = "{{ job.nmae }} succeeded"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`"job" has no attribute "nmae"`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = 1
+  notification_type = "webhook"
+  webhook = {
+    url = "https://hooks.example.com/awx"
+  }
+  messages = {
+    workflow_approval = {
+      approved = {
+        message = "{{ job.name }} approved"
+      }
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`unknown variable "job"`),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_notification_template" "test" {
+  name              = "test"
+  organization      = 1
+  notification_type = "webhook"
+  webhook = {
+    url = "https://hooks.example.com/awx"
+  }
+  messages = {
+    error = {
+      message = "failed"
+      body    = "{% if job.failed %}{{ job.job_explanation }}"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`{% if %} block is never closed`),
+			},
+		},
+	})
+}
+
+// testCheckNotificationConfiguration checks a field of the notification_configuration the server stored.
+func testCheckNotificationConfiguration(server *awxmock.Server, resourceName, key string, expected any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := strconv.Atoi(s.RootModule().Resources[resourceName].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		obj, _ := server.Get("notification_templates", id)
+		config, _ := obj["notification_configuration"].(map[string]any)
+		if config[key] != expected {
+			return fmt.Errorf("expected %s of %s to be %v, got %v", key, resourceName, expected, config[key])
+		}
+		return nil
+	}
+}
+
+// testCheckNotificationMessages checks the messages the server stored.
+func testCheckNotificationMessages(server *awxmock.Server, resourceName string, check func(map[string]any) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := strconv.Atoi(s.RootModule().Resources[resourceName].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		obj, _ := server.Get("notification_templates", id)
+		messages, _ := obj["messages"].(map[string]any)
+		return check(messages)
+	}
+}
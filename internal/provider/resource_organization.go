@@ -111,12 +111,10 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		bodyData.MaxHosts = int(data.MaxHosts.ValueInt32())
 	}
 
-	url := "/api/v2/organizations/"
+	url := r.client.APIPath("organizations/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -141,7 +139,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/organizations/%d/", id)
+	url := r.client.APIPath("organizations/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -230,12 +228,10 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		bodyData.MaxHosts = int(data.MaxHosts.ValueInt32())
 	}
 
-	url := fmt.Sprintf("/api/v2/organizations/%d/", id)
+	url := r.client.APIPath("organizations/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -257,7 +253,7 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/organizations/%d/", id)
+	url := r.client.APIPath("organizations/%d/", id)
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewOrganizationAdminResource() resource.Resource {
+	return &membershipResource{
+		config: membershipConfig{
+			typeName:           "_organization_admin",
+			description:        "Manage the users that are administrators of an AWX organization. Take care when authoritative that the user terraform authenticates as is listed, or is a superuser, or it may lose access to the organization.",
+			parentAttribute:    "organization_id",
+			parentDescription:  "The ID of the organization.",
+			relatedPath:        "organizations/%d/admins/",
+			membersAttribute:   "user_ids",
+			membersDescription: "The IDs of the users that are administrators of the organization.",
+		},
+	}
+}
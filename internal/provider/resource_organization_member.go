@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &membershipResource{
+		config: membershipConfig{
+			typeName:           "_organization_member",
+			description:        "Manage the users that are members of an AWX organization.",
+			parentAttribute:    "organization_id",
+			parentDescription:  "The ID of the organization.",
+			relatedPath:        "organizations/%d/users/",
+			membersAttribute:   "user_ids",
+			membersDescription: "The IDs of the users that are members of the organization.",
+		},
+	}
+}
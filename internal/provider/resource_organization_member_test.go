@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitOrganizationMemberResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_user" "test" {
+  count    = 2
+  username = "test-${count.index}"
+  password = "secret"
+}
+
+resource "awx_organization_member" "test" {
+  organization_id = awx_organization.test.id
+  user_ids        = awx_user.test[*].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_organization_member.test", "user_ids.#", "2"),
+					resource.TestCheckResourceAttr("awx_organization_member.test", "authoritative", "true"),
+					testCheckRelatedCount(server, "awx_organization_member.test", "organization_id", "organizations", "users", 2),
+				),
+			},
+			{
+				ResourceName:                         "awx_organization_member.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "organization_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_organization_member.test", "organization_id"),
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_user" "test" {
+  count    = 2
+  username = "test-${count.index}"
+  password = "secret"
+}
+
+resource "awx_organization_member" "test" {
+  organization_id = awx_organization.test.id
+  user_ids        = [awx_user.test[1].id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_organization_member.test", "user_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_organization_member.test", "organization_id", "organizations", "users", 1),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitOrganizationResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var organizationId int
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "test"
+  description = "test organization"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_organization.test", "id"),
+					resource.TestCheckResourceAttr("awx_organization.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_organization.test", "description", "test organization"),
+					resource.TestCheckResourceAttr("awx_organization.test", "max_hosts", "0"),
+				),
+			},
+			{
+				ResourceName:      "awx_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "renamed"
+  description = "renamed organization"
+  max_hosts   = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_organization.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_organization.test", "max_hosts", "10"),
+					resource.TestCheckResourceAttr("awx_organization.test", "description", "renamed organization"),
+					func(s *terraform.State) (err error) {
+						organizationId, err = strconv.Atoi(s.RootModule().Resources["awx_organization.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				// an organization deleted outside of terraform is removed from state and planned again
+				PreConfig: func() {
+					server.Delete("organizations", organizationId)
+				},
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "renamed"
+  description = "renamed organization"
+  max_hosts   = 10
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name        = "renamed"
+  description = "renamed organization"
+  max_hosts   = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["awx_organization.test"].Primary.ID; id == strconv.Itoa(organizationId) {
+							return fmt.Errorf("expected the organization to be created again, it still has id %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
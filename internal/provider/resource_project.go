@@ -345,12 +345,10 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		bodyData.ScmUrl = data.ScmUrl.ValueString()
 	}
 
-	url := "/api/v2/projects/"
+	url := r.client.APIPath("projects/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -387,7 +385,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/projects/%d/", id)
+	url := r.client.APIPath("projects/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -559,12 +557,10 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		bodyData.ScmUrl = data.ScmUrl.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/projects/%d/", id)
+	url := r.client.APIPath("projects/%d/", id)
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -600,7 +596,7 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/projects/%d/", id)
+	url := r.client.APIPath("projects/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
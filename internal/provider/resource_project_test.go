@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitProjectResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  description  = "test project"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+  scm_branch   = "master"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_project.test", "id"),
+					resource.TestCheckResourceAttr("awx_project.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_project.test", "scm_type", "git"),
+				),
+			},
+			{
+				ResourceName:      "awx_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Read only refreshes these attributes once they are in state.
+				ImportStateVerifyIgnore: []string{"allow_override", "scm_clean", "scm_delete_on_update", "scm_track_submodules", "scm_update_on_launch"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name                 = "renamed"
+  description          = "renamed project"
+  organization         = awx_organization.test.id
+  scm_type             = "git"
+  scm_url              = "https://github.com/ansible/ansible-tower-samples"
+  scm_branch           = "main"
+  scm_update_on_launch = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_project.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_project.test", "scm_branch", "main"),
+					resource.TestCheckResourceAttr("awx_project.test", "scm_update_on_launch", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &RoleAssignmentResource{}
+
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+type RoleAssignmentResource struct {
+	client *AwxClient
+}
+
+func (r *RoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grant a user or team one of the roles of an object, e.g. the `execute_role` of a job template. Every attribute forces a new assignment when changed. On a controller advertising the role based access control API of AWX 24 and later, the role is granted with a `role_user_assignments` or `role_team_assignments` object of its role definition, e.g. `JobTemplate Execute` for the `execute_role` of a job template. Otherwise it is granted through the legacy `roles` API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The assignment's import ID, `<resource_type>/<resource_id>/<role>/user/<user_id>` or `<resource_type>/<resource_id>/<role>/team/<team_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the object the role belongs to. Options: `%s`.", strings.Join(roleResourceTypeNames(), "`, `")),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleResourceTypeNames()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.Int32Attribute{
+				Description: "ID of the object the role belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role's field on the object, e.g. `admin_role`, `execute_role`, `read_role`, `use_role` or `update_role`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int32Attribute{
+				Description: "ID of the role, resolved from the object's `summary_fields.object_roles`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int32Attribute{
+				Description: "ID of the user to grant the role to. Exactly one of `user_id` and `team_id` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				Description: "ID of the team to grant the role to. Exactly one of `user_id` and `team_id` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r RoleAssignmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
+func (r *RoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+// principal returns the related collection of the role the assignment goes through, users or
+// teams, along with the id of the user or team.
+func (data RoleAssignmentModel) principal() (kind string, id int) {
+	if !data.TeamId.IsNull() {
+		return "teams", int(data.TeamId.ValueInt32())
+	}
+	return "users", int(data.UserId.ValueInt32())
+}
+
+// assignmentCollection returns the collection of the role based access control API that holds
+// the assignment, e.g. role_user_assignments, along with the field of its principal, e.g. user.
+func (data RoleAssignmentModel) assignmentCollection() (collection, field string) {
+	kind, _ := data.principal()
+	field = strings.TrimSuffix(kind, "s")
+	return fmt.Sprintf("role_%s_assignments", field), field
+}
+
+func (data RoleAssignmentModel) importId() string {
+	kind, id := data.principal()
+	return fmt.Sprintf("%s/%d/%s/%s/%d", data.ResourceType.ValueString(), data.ResourceId.ValueInt32(), data.Role.ValueString(), strings.TrimSuffix(kind, "s"), id)
+}
+
+// usesRoleDefinitions reports whether the controller has the role based access control API of
+// AWX 24 and later, which replaces the legacy roles API. Unlike advertises, a controller whose
+// endpoints could not be read is not assumed to have it: the legacy API works on every version.
+func (r *RoleAssignmentResource) usesRoleDefinitions() bool {
+	return r.client.endpoints != nil && r.client.endpoints["role_definitions"]
+}
+
+// listAssignments returns the ids of the role based access control API's assignments granting
+// roleDefinition on the assignment's object to its principal.
+func (r *RoleAssignmentResource) listAssignments(ctx context.Context, data RoleAssignmentModel, roleDefinition int) ([]int, error) {
+	collection, field := data.assignmentCollection()
+	_, id := data.principal()
+	url := r.client.APIPath("%s/?role_definition=%d&%s=%d&object_id=%d", collection, roleDefinition, field, id, data.ResourceId.ValueInt32())
+	ids, _, err := r.client.ListChildIds(ctx, url, []int{200})
+	return ids, err
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, statusCode, err := r.client.lookupObjectRole(ctx, data.ResourceType.ValueString(), int(data.ResourceId.ValueInt32()), data.Role.ValueString())
+	if err == nil && statusCode == 404 {
+		err = fmt.Errorf("%s %d does not exist", data.ResourceType.ValueString(), data.ResourceId.ValueInt32())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Unable to look up role",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	kind, id := data.principal()
+	if r.usesRoleDefinitions() {
+		roleDefinition, err := r.client.lookupRoleDefinition(ctx, data.ResourceType.ValueString(), data.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role"),
+				"Unable to look up role definition",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		collection, field := data.assignmentCollection()
+		bodyData := map[string]any{
+			"role_definition": roleDefinition,
+			field:             id,
+			"object_id":       strconv.Itoa(int(data.ResourceId.ValueInt32())),
+		}
+		_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, r.client.APIPath("%s/", collection), bodyData, []int{201})
+		if err != nil {
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Failed to assign role", err)
+			return
+		}
+	} else {
+		url := r.client.APIPath("roles/%d/%s/", role.Id, kind)
+		_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, ChildResult{Id: id}, []int{204})
+		if err != nil {
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Failed to assign role", err)
+			return
+		}
+	}
+
+	data.RoleId = types.Int32Value(int32(role.Id))
+	data.Id = types.StringValue(data.importId())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, statusCode, err := r.client.lookupObjectRole(ctx, data.ResourceType.ValueString(), int(data.ResourceId.ValueInt32()), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to look up role",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	// the object is gone and its roles with it
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var assigned bool
+	if r.usesRoleDefinitions() {
+		roleDefinition, err := r.client.lookupRoleDefinition(ctx, data.ResourceType.ValueString(), data.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to look up role definition",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		assignmentIds, err := r.listAssignments(ctx, data, roleDefinition)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+		assigned = len(assignmentIds) > 0
+	} else {
+		kind, id := data.principal()
+		url := r.client.APIPath("roles/%d/%s/?id=%d", role.Id, kind, id)
+		assignedIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+		assigned = slices.Contains(assignedIds, id)
+	}
+
+	if !assigned {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.RoleId = types.Int32Value(int32(role.Id))
+	data.Id = types.StringValue(data.importId())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called, every attribute requires the assignment to be replaced.
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleAssignmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleAssignmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.usesRoleDefinitions() {
+		roleDefinition, err := r.client.lookupRoleDefinition(ctx, data.ResourceType.ValueString(), data.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to look up role definition",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		assignmentIds, err := r.listAssignments(ctx, data, roleDefinition)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making API http request",
+				fmt.Sprintf("Error was: %s.", err.Error()))
+			return
+		}
+
+		collection, _ := data.assignmentCollection()
+		for _, assignmentId := range assignmentIds {
+			url := r.client.APIPath("%s/%d/", collection, assignmentId)
+			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Failed to remove role assignment",
+					fmt.Sprintf("Error was: %s.", err.Error()))
+				return
+			}
+		}
+		return
+	}
+
+	kind, id := data.principal()
+	url := r.client.APIPath("roles/%d/%s/", data.RoleId.ValueInt32(), kind)
+
+	// a role that is already gone, with the object it belonged to, needs no disassociating
+	_, _, err := r.client.GenericAPIRequest(ctx, http.MethodPost, url, ChildDissasocBody{Id: id, Disassociate: true}, []int{204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove role assignment",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *RoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	var resourceId, principalId int
+	var err error
+	if len(parts) == 5 {
+		resourceId, err = strconv.Atoi(parts[1])
+		if err == nil {
+			principalId, err = strconv.Atoi(parts[4])
+		}
+	}
+	if len(parts) != 5 || err != nil || (parts[3] != "user" && parts[3] != "team") {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected <resource_type>/<resource_id>/<role>/user/<user_id> or <resource_type>/<resource_id>/<role>/team/<team_id>, got: %q.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), int32(resourceId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parts[3]+"_id"), int32(principalId))...)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitRoleAssignmentResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	// the managed role definitions AWX 24 creates, and one it creates for backwards compatibility
+	executeId := server.Add("role_definitions", awxmock.Object{"name": "JobTemplate Execute", "content_type": "awx.jobtemplate", "managed": true})
+	adminId := server.Add("role_definitions", awxmock.Object{"name": "JobTemplate Admin", "content_type": "awx.jobtemplate", "managed": true})
+	useId := server.Add("role_definitions", awxmock.Object{"name": "Project Use Compat", "content_type": "awx.project", "managed": true})
+
+	config := func(assignments string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_user" "test" {
+  username = "test"
+  password = "secret"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+` + assignments
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "execute_role"
+  user_id       = awx_user.test.id
+}
+
+resource "awx_role_assignment" "team" {
+  resource_type = "project"
+  resource_id   = awx_project.test.id
+  role          = "use_role"
+  team_id       = awx_team.test.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRoleAssignments(server, "awx_role_assignment.user", "role_user_assignments", "user_id", executeId, 1),
+					testCheckRoleAssignments(server, "awx_role_assignment.team", "role_team_assignments", "team_id", useId, 1),
+					// nothing goes through the legacy roles API
+					testCheckRelatedCount(server, "awx_role_assignment.user", "role_id", "roles", "users", 0),
+					testCheckRelatedCount(server, "awx_role_assignment.team", "role_id", "roles", "teams", 0),
+				),
+			},
+			{
+				ResourceName:      "awx_role_assignment.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// an assignment removed outside of terraform is planned again
+				PreConfig: func() {
+					for _, assignment := range server.List("role_team_assignments", nil) {
+						server.Delete("role_team_assignments", int(assignment["id"].(float64)))
+					}
+				},
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "execute_role"
+  user_id       = awx_user.test.id
+}
+
+resource "awx_role_assignment" "team" {
+  resource_type = "project"
+  resource_id   = awx_project.test.id
+  role          = "use_role"
+  team_id       = awx_team.test.id
+}
+`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// changing the role replaces the assignment, removing the team's deletes its assignment
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "admin_role"
+  user_id       = awx_user.test.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRoleAssignments(server, "awx_role_assignment.user", "role_user_assignments", "user_id", executeId, 0),
+					testCheckRoleAssignments(server, "awx_role_assignment.user", "role_user_assignments", "user_id", adminId, 1),
+				),
+			},
+			{
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "read_role"
+  user_id       = awx_user.test.id
+}
+`),
+				ExpectError: regexp.MustCompile(`no role definition grants read_role on job_template objects`),
+			},
+		},
+	})
+}
+
+// testCheckRoleAssignments checks the number of assignments in collection granting roleDefinition
+// on resourceName's object to the user or team in its principalAttribute.
+func testCheckRoleAssignments(server *awxmock.Server, resourceName, collection, principalAttribute string, roleDefinition, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		attributes := rs.Primary.Attributes
+		assignments := server.List(collection, awxmock.Object{
+			"role_definition": roleDefinition,
+			strings.TrimSuffix(principalAttribute, "_id"): attributes[principalAttribute],
+			"object_id": attributes["resource_id"],
+		})
+		if len(assignments) != count {
+			return fmt.Errorf("expected %d %s of role definition %d, got %d", count, collection, roleDefinition, len(assignments))
+		}
+		return nil
+	}
+}
+
+func TestUnitRoleAssignmentResourceLegacy(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	// a controller without the role based access control API of AWX 24
+	server.Endpoints = nil
+	for _, endpoint := range awxmock.DefaultEndpoints {
+		if !strings.HasPrefix(endpoint, "role_") {
+			server.Endpoints = append(server.Endpoints, endpoint)
+		}
+	}
+
+	config := func(assignments string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_user" "test" {
+  username = "test"
+  password = "secret"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+` + assignments
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "execute_role"
+  user_id       = awx_user.test.id
+}
+
+resource "awx_role_assignment" "team" {
+  resource_type = "project"
+  resource_id   = awx_project.test.id
+  role          = "use_role"
+  team_id       = awx_team.test.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_role_assignment.user", "role_id"),
+					resource.TestCheckResourceAttrSet("awx_role_assignment.team", "role_id"),
+					testCheckRelatedCount(server, "awx_role_assignment.user", "role_id", "roles", "users", 1),
+					testCheckRelatedCount(server, "awx_role_assignment.team", "role_id", "roles", "teams", 1),
+				),
+			},
+			{
+				ResourceName:      "awx_role_assignment.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "awx_role_assignment.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// changing the role replaces the assignment
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "admin_role"
+  user_id       = awx_user.test.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRelatedCount(server, "awx_role_assignment.user", "role_id", "roles", "users", 1),
+					resource.TestMatchResourceAttr("awx_role_assignment.user", "id", regexp.MustCompile(`^job_template/\d+/admin_role/user/\d+$`)),
+				),
+			},
+			{
+				Config: config(`
+resource "awx_role_assignment" "user" {
+  resource_type = "job_template"
+  resource_id   = awx_job_template.test.id
+  role          = "use_role"
+  user_id       = awx_user.test.id
+}
+`),
+				ExpectError: regexp.MustCompile(`has no role "use_role"`),
+			},
+		},
+	})
+}
+
+func TestUnitRoleAssignmentResourceUnknownEndpoints(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	// a controller whose endpoints cannot be read is granted roles through the legacy roles API
+	server.RootUnreadable = true
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_user" "test" {
+  username = "test"
+  password = "secret"
+}
+
+resource "awx_role_assignment" "test" {
+  resource_type = "organization"
+  resource_id   = awx_organization.test.id
+  role          = "member_role"
+  user_id       = awx_user.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRelatedCount(server, "awx_role_assignment.test", "role_id", "roles", "users", 1),
+					func(s *terraform.State) error {
+						if assignments := server.List("role_user_assignments", awxmock.Object{}); len(assignments) != 0 {
+							return fmt.Errorf("expected no role_user_assignments, got %d", len(assignments))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitRoleDefinitionResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_role_definition" "test" {
+  name         = "Inventory Viewer"
+  description  = "May view an inventory"
+  permissions  = ["awx.view_inventory"]
+  content_type = "awx.inventory"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_role_definition.test", "id"),
+					resource.TestCheckResourceAttr("awx_role_definition.test", "name", "Inventory Viewer"),
+					resource.TestCheckResourceAttr("awx_role_definition.test", "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "awx_role_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_role_definition" "test" {
+  name         = "Inventory Operator"
+  permissions  = ["awx.view_inventory", "awx.update_inventory", "awx.adhoc_inventory"]
+  content_type = "awx.inventory"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_role_definition.test", "name", "Inventory Operator"),
+					resource.TestCheckNoResourceAttr("awx_role_definition.test", "description"),
+					resource.TestCheckResourceAttr("awx_role_definition.test", "permissions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitRoleDefinitionResourceLegacyController(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+	server.Version = "23.9.0"
+	server.Endpoints = []string{"job_templates", "roles"}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_role_definition" "test" {
+  name        = "Inventory Viewer"
+  permissions = ["awx.view_inventory"]
+}
+`,
+				ExpectError: regexp.MustCompile(`does not advertise /role_definitions/`),
+			},
+		},
+	})
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &roleObjectAssignmentResource{}
+var _ resource.ResourceWithImportState = &roleObjectAssignmentResource{}
+var _ resource.ResourceWithModifyPlan = &roleObjectAssignmentResource{}
+
+// roleObjectAssignmentConfig describes a resource that grants a role definition to an assignee,
+// a user or a team, through the role based access control API of AWX 24 and later.
+type roleObjectAssignmentConfig struct {
+	// typeName is appended to the provider type name, e.g. "_role_user_assignment".
+	typeName    string
+	description string
+
+	// collection is the assignments' endpoint, e.g. "role_user_assignments".
+	collection string
+	// assigneeAttribute is both the attribute and the API field holding the assignee's id, e.g. "user".
+	assigneeAttribute   string
+	assigneeDescription string
+}
+
+// roleObjectAssignmentResource manages a single role assignment. Assignments cannot be changed,
+// so every attribute forces a new one.
+type roleObjectAssignmentResource struct {
+	client *AwxClient
+	config roleObjectAssignmentConfig
+}
+
+// roleObjectAssignmentData is the state of a role assignment. The assignee attribute's name varies
+// between resources, so it is read and written attribute by attribute rather than with a model struct.
+type roleObjectAssignmentData struct {
+	Id             types.String
+	RoleDefinition types.Int32
+	Assignee       types.Int32
+	ObjectId       types.String
+}
+
+func (r *roleObjectAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.config.typeName
+}
+
+func (r *roleObjectAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.config.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Role assignment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_definition": schema.Int32Attribute{
+				Required:    true,
+				Description: "ID of the role definition to grant, e.g. from `awx_role_definition`.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			r.config.assigneeAttribute: schema.Int32Attribute{
+				Required:    true,
+				Description: r.config.assigneeDescription,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the object the role is granted on, of the role definition's content type. Leave unset for a role definition granted system wide.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleObjectAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	configureData, ok := req.ProviderData.(*AwxClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = configureData
+}
+
+// ModifyPlan rejects assignments on a controller without the role based access control API.
+func (r *roleObjectAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.checkEndpoint(r.config.collection, "it does not have the role based access control API introduced in AWX 24. Grant the roles of an object with awx_role_assignment instead.", &resp.Diagnostics)
+}
+
+func (r *roleObjectAssignmentResource) get(ctx context.Context, source attributeGetter, diags *diag.Diagnostics) (data roleObjectAssignmentData) {
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("role_definition"), &data.RoleDefinition)...)
+	diags.Append(source.GetAttribute(ctx, path.Root(r.config.assigneeAttribute), &data.Assignee)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("object_id"), &data.ObjectId)...)
+	return
+}
+
+func (r *roleObjectAssignmentResource) set(ctx context.Context, state attributeSetter, data roleObjectAssignmentData, diags *diag.Diagnostics) {
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("role_definition"), data.RoleDefinition)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.config.assigneeAttribute), data.Assignee)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("object_id"), data.ObjectId)...)
+}
+
+func (r *roleObjectAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := r.get(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bodyData := map[string]any{
+		"role_definition":          data.RoleDefinition.ValueInt32(),
+		r.config.assigneeAttribute: data.Assignee.ValueInt32(),
+	}
+	if !data.ObjectId.IsNull() {
+		bodyData["object_id"] = data.ObjectId.ValueString()
+	}
+
+	url := r.client.APIPath("%s/", r.config.collection)
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+func (r *roleObjectAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := r.get(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id))
+		return
+	}
+
+	url := r.client.APIPath("%s/%d/", r.config.collection, id)
+	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API http request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var responseData map[string]any
+
+	err = json.Unmarshal(body, &responseData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal json",
+			fmt.Sprintf("bodyData: %+v.", body))
+		return
+	}
+
+	if roleDefinition, ok := responseData["role_definition"].(float64); ok {
+		data.RoleDefinition = types.Int32Value(int32(roleDefinition))
+	}
+	if assignee, ok := responseData[r.config.assigneeAttribute].(float64); ok {
+		data.Assignee = types.Int32Value(int32(assignee))
+	}
+	// object_id is a string as objects may have non integer primary keys, but tolerate a number
+	if objectId, ok := responseData["object_id"]; ok && objectId != nil {
+		data.ObjectId = types.StringValue(fmt.Sprint(objectId))
+	} else {
+		data.ObjectId = types.StringNull()
+	}
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+// Update is never called, every attribute requires the assignment to be replaced.
+func (r *roleObjectAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := r.get(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &resp.State, data, &resp.Diagnostics)
+}
+
+func (r *roleObjectAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := r.get(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
+		return
+	}
+	url := r.client.APIPath("%s/%d/", r.config.collection, id)
+
+	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204, 404})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error making API delete request",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+}
+
+func (r *roleObjectAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewRoleTeamAssignmentResource() resource.Resource {
+	return &roleObjectAssignmentResource{
+		config: roleObjectAssignmentConfig{
+			typeName:            "_role_team_assignment",
+			description:         "Grant a team a role definition, on an object or system wide, through the role based access control API of AWX 24 and later.",
+			collection:          "role_team_assignments",
+			assigneeAttribute:   "team",
+			assigneeDescription: "ID of the team to grant the role to.",
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitRoleTeamAssignmentResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_role_definition" "test" {
+  name        = "Auditor"
+  permissions = ["awx.view_inventory", "awx.view_project"]
+}
+
+resource "awx_role_team_assignment" "test" {
+  role_definition = awx_role_definition.test.id
+  team            = awx_team.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_role_team_assignment.test", "id"),
+					resource.TestCheckResourceAttrPair("awx_role_team_assignment.test", "team", "awx_team.test", "id"),
+					resource.TestCheckNoResourceAttr("awx_role_team_assignment.test", "object_id"),
+				),
+			},
+			{
+				ResourceName:      "awx_role_team_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewRoleUserAssignmentResource() resource.Resource {
+	return &roleObjectAssignmentResource{
+		config: roleObjectAssignmentConfig{
+			typeName:            "_role_user_assignment",
+			description:         "Grant a user a role definition, on an object or system wide, through the role based access control API of AWX 24 and later.",
+			collection:          "role_user_assignments",
+			assigneeAttribute:   "user",
+			assigneeDescription: "ID of the user to grant the role to.",
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestUnitRoleUserAssignmentResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	config := func(objectId string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_inventory" "test" {
+  count        = 2
+  name         = "test-${count.index}"
+  organization = awx_organization.test.id
+}
+
+resource "awx_user" "test" {
+  username = "test"
+  password = "secret"
+}
+
+resource "awx_role_definition" "test" {
+  name         = "Inventory Viewer"
+  permissions  = ["awx.view_inventory"]
+  content_type = "awx.inventory"
+}
+
+resource "awx_role_user_assignment" "test" {
+  role_definition = awx_role_definition.test.id
+  user            = awx_user.test.id
+  object_id       = ` + objectId + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_inventory.test[0].id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_role_user_assignment.test", "id"),
+					resource.TestCheckResourceAttrPair("awx_role_user_assignment.test", "user", "awx_user.test", "id"),
+					resource.TestCheckResourceAttrPair("awx_role_user_assignment.test", "object_id", "awx_inventory.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:      "awx_role_user_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("awx_inventory.test[1].id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("awx_role_user_assignment.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("awx_role_user_assignment.test", "object_id", "awx_inventory.test.1", "id"),
+				),
+			},
+		},
+	})
+}
@@ -104,12 +104,10 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		bodyData.Description = data.Description.ValueString()
 	}
 
-	url := "/api/v2/schedules/"
+	url := r.client.APIPath("schedules/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -144,7 +142,7 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/schedules/%d/", id)
+	url := r.client.APIPath("schedules/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -208,12 +206,10 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		bodyData.Description = data.Description.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/schedules/%d/", id)
+	url := r.client.APIPath("schedules/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -236,7 +232,7 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/schedules/%d/", id)
+	url := r.client.APIPath("schedules/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
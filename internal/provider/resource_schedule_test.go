@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitScheduleResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_schedule" "test" {
+  name                 = "test"
+  description          = "test schedule"
+  unified_job_template = awx_job_template.test.id
+  rrule                = "DTSTART;TZID=UTC:20250101T000000 RRULE:FREQ=DAILY;INTERVAL=1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_schedule.test", "id"),
+					resource.TestCheckResourceAttr("awx_schedule.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_schedule.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "awx_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_schedule" "test" {
+  name                 = "renamed"
+  description          = "renamed schedule"
+  unified_job_template = awx_job_template.test.id
+  rrule                = "DTSTART;TZID=UTC:20250101T000000 RRULE:FREQ=WEEKLY;INTERVAL=1"
+  enabled              = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_schedule.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_schedule.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
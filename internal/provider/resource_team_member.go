@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewTeamMemberResource() resource.Resource {
+	return &membershipResource{
+		config: membershipConfig{
+			typeName:           "_team_member",
+			description:        "Manage the users that are members of an AWX team.",
+			parentAttribute:    "team_id",
+			parentDescription:  "The ID of the team.",
+			relatedPath:        "teams/%d/users/",
+			membersAttribute:   "user_ids",
+			membersDescription: "The IDs of the users that are members of the team.",
+		},
+	}
+}
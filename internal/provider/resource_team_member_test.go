@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitTeamMemberResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	outsiderId := server.Add("users", awxmock.Object{"username": "outsider"})
+	var teamId int
+
+	config := func(members, authoritative string) string {
+		return testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_user" "test" {
+  count    = 2
+  username = "test-${count.index}"
+  password = "secret"
+}
+
+resource "awx_team_member" "test" {
+  team_id       = awx_team.test.id
+  user_ids      = ` + members + `
+  authoritative = ` + authoritative + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("awx_user.test[*].id", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "user_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 2),
+					func(s *terraform.State) (err error) {
+						teamId, err = strconv.Atoi(s.RootModule().Resources["awx_team.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:                         "awx_team_member.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+				ImportStateIdFunc:                    testImportStateIdFromAttribute("awx_team_member.test", "team_id"),
+			},
+			{
+				Config: config("[awx_user.test[0].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "user_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 1),
+				),
+			},
+			{
+				// a member added outside of terraform is drift when authoritative
+				PreConfig: func() {
+					server.Associate("teams", teamId, "users", outsiderId)
+				},
+				Config:             config("[awx_user.test[0].id]", "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config("[awx_user.test[0].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 1),
+				),
+			},
+			{
+				Config: config("[awx_user.test[0].id]", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "authoritative", "false"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 1),
+				),
+			},
+			{
+				// and is left alone when additive
+				PreConfig: func() {
+					server.Associate("teams", teamId, "users", outsiderId)
+				},
+				Config:   config("[awx_user.test[0].id]", "false"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("awx_user.test[*].id", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "user_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 3),
+				),
+			},
+			{
+				Config: config("[awx_user.test[1].id]", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "user_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 2),
+				),
+			},
+			{
+				// switching back to authoritative removes it
+				Config: config("[awx_user.test[1].id]", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team_member.test", "user_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_team_member.test", "team_id", "teams", "users", 1),
+				),
+			},
+		},
+	})
+}
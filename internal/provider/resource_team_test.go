@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUnitTeamResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	var teamId int
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "test"
+  description  = "test team"
+  organization = awx_organization.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_team.test", "id"),
+					resource.TestCheckResourceAttr("awx_team.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_team.test", "description", "test team"),
+					resource.TestCheckResourceAttrPair("awx_team.test", "organization", "awx_organization.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "awx_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "renamed"
+  description  = "renamed team"
+  organization = awx_organization.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_team.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_team.test", "description", "renamed team"),
+					func(s *terraform.State) (err error) {
+						teamId, err = strconv.Atoi(s.RootModule().Resources["awx_team.test"].Primary.ID)
+						return err
+					},
+				),
+			},
+			{
+				// a team deleted outside of terraform is removed from state and planned again
+				PreConfig: func() {
+					server.Delete("teams", teamId)
+				},
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "renamed"
+  description  = "renamed team"
+  organization = awx_organization.test.id
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_team" "test" {
+  name         = "renamed"
+  description  = "renamed team"
+  organization = awx_organization.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["awx_team.test"].Primary.ID; id == strconv.Itoa(teamId) {
+							return fmt.Errorf("expected the team to be created again, it still has id %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
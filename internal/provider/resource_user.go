@@ -129,12 +129,10 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		bodyData.Email = data.Email.ValueString()
 	}
 
-	url := "/api/v2/users/"
+	url := r.client.APIPath("users/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -159,7 +157,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/users/%d/", id)
+	url := r.client.APIPath("users/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -250,12 +248,10 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		bodyData.Email = data.Email.ValueString()
 	}
 
-	url := fmt.Sprintf("/api/v2/users/%d/", id)
+	url := r.client.APIPath("users/%d/", id)
 	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -277,7 +273,7 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 			fmt.Sprintf("Unable to convert id: %v.", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/users/%d/", id)
+	url := r.client.APIPath("users/%d/", id)
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
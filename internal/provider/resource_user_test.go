@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitUserResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_user" "test" {
+  username   = "test"
+  password   = "secret"
+  first_name = "Test"
+  last_name  = "User"
+  email      = "test@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_user.test", "id"),
+					resource.TestCheckResourceAttr("awx_user.test", "username", "test"),
+					resource.TestCheckResourceAttr("awx_user.test", "is_superuser", "false"),
+				),
+			},
+			{
+				ResourceName:            "awx_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_user" "test" {
+  username     = "renamed"
+  password     = "secret"
+  first_name   = "Renamed"
+  last_name    = "User"
+  email        = "renamed@example.com"
+  is_superuser = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_user.test", "username", "renamed"),
+					resource.TestCheckResourceAttr("awx_user.test", "is_superuser", "true"),
+				),
+			},
+		},
+	})
+}
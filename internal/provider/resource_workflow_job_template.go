@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &WorkflowJobTemplatesResource{}
 var _ resource.ResourceWithImportState = &WorkflowJobTemplatesResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowJobTemplatesResource{}
 
 func NewWorkflowJobTemplatesResource() resource.Resource {
 	return &WorkflowJobTemplatesResource{}
@@ -50,6 +51,9 @@ type WorkflowJobTemplatesResourceModel struct {
 	AskTagsOnLaunch      types.Bool   `tfsdk:"ask_tags_on_launch"`
 	SkipTags             types.String `tfsdk:"skip_tags"`
 	JobTags              types.String `tfsdk:"job_tags"`
+	WebhookKey           types.String `tfsdk:"webhook_key"`
+	WebhookURL           types.String `tfsdk:"webhook_url"`
+	RotateWebhookKey     types.String `tfsdk:"rotate_webhook_key"`
 }
 
 type WorkflowJobTemplateAPIModel struct {
@@ -188,6 +192,10 @@ func (r *WorkflowJobTemplatesResource) Schema(ctx context.Context, req resource.
 			},
 		},
 	}
+
+	for name, attribute := range webhookAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r *WorkflowJobTemplatesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -209,6 +217,17 @@ func (r *WorkflowJobTemplatesResource) Configure(ctx context.Context, req resour
 	r.client = configureData
 }
 
+// ModifyPlan rejects attributes the configured controller is too old to support, and keeps
+// the webhook key and URL known when they are not going to change.
+func (r *WorkflowJobTemplatesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.client.checkBoolFeature(ctx, req.Config, path.Root("ask_labels_on_launch"), featurePromptLabelsAndInstanceGroups, &resp.Diagnostics)
+	planWebhook(ctx, req, resp)
+}
+
 func (r *WorkflowJobTemplatesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkflowJobTemplatesResourceModel
 
@@ -281,12 +300,10 @@ func (r *WorkflowJobTemplatesResource) Create(ctx context.Context, req resource.
 		bodyData.JobTags = data.JobTags.ValueString()
 	}
 
-	url := "/api/v2/workflow_job_templates/"
+	url := r.client.APIPath("workflow_job_templates/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -302,6 +319,21 @@ func (r *WorkflowJobTemplatesResource) Create(ctx context.Context, req resource.
 
 	data.Id = types.StringValue(fmt.Sprintf("%v", returnedData["id"]))
 
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable convert id from string to int",
+			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
+		return
+	}
+
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "workflow_job_templates", id, returnedData, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.WebhookKey = types.StringValue(webhookKey)
+	data.WebhookURL = types.StringValue(webhookURL)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -321,7 +353,7 @@ func (r *WorkflowJobTemplatesResource) Read(ctx context.Context, req resource.Re
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_templates/%d/", id)
+	url := r.client.APIPath("workflow_job_templates/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -475,6 +507,20 @@ func (r *WorkflowJobTemplatesResource) Read(ctx context.Context, req resource.Re
 		}
 	}
 
+	var template map[string]any
+	if err = json.Unmarshal(body, &template); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable unmarshal response body into object",
+			fmt.Sprintf("Error =  %v. ", err.Error()))
+		return
+	}
+
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "workflow_job_templates", id, template, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_key"), webhookKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_url"), webhookURL)...)
 }
 
 func (r *WorkflowJobTemplatesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -522,14 +568,27 @@ func (r *WorkflowJobTemplatesResource) Update(ctx context.Context, req resource.
 	bodyData.SkipTags = data.SkipTags.ValueString()
 	bodyData.JobTags = data.JobTags.ValueString()
 
-	url := fmt.Sprintf("/api/v2/workflow_job_templates/%d/", id)
-	_, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
+	url := r.client.APIPath("workflow_job_templates/%d/", id)
+	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
+		return
+	}
+
+	var rotateWebhookKey types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_webhook_key"), &rotateWebhookKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only a template with a webhook service has a key to rotate
+	rotate := data.WebhookService.ValueString() != "" && !data.RotateWebhookKey.Equal(rotateWebhookKey)
+	webhookKey, webhookURL := r.client.readWebhook(ctx, "workflow_job_templates", id, returnedData, rotate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.WebhookKey = types.StringValue(webhookKey)
+	data.WebhookURL = types.StringValue(webhookURL)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -549,7 +608,7 @@ func (r *WorkflowJobTemplatesResource) Delete(ctx context.Context, req resource.
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_templates/%d/", id)
+	url := r.client.APIPath("workflow_job_templates/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
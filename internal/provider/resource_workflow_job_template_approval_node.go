@@ -115,7 +115,7 @@ func (r *WorkflowJobTemplateApprovalNode) Create(ctx context.Context, req resour
 	/////////////////////////////////////////////////////
 	// First create an empty node
 
-	url := fmt.Sprintf("/api/v2/workflow_job_templates/%d/workflow_nodes/", data.WorkflowJobTemplateId.ValueInt32())
+	url := r.client.APIPath("workflow_job_templates/%d/workflow_nodes/", data.WorkflowJobTemplateId.ValueInt32())
 
 	newJTworkflowNode := struct {
 		NodeType string `json:"node_type"`
@@ -125,9 +125,7 @@ func (r *WorkflowJobTemplateApprovalNode) Create(ctx context.Context, req resour
 
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, newJTworkflowNode, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -166,13 +164,11 @@ func (r *WorkflowJobTemplateApprovalNode) Create(ctx context.Context, req resour
 		return
 	}
 
-	url = fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/create_approval_template/", tempIdInt)
+	url = r.client.APIPath("workflow_job_template_nodes/%d/create_approval_template/", tempIdInt)
 
 	returnedData, _, err = r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -218,7 +214,7 @@ func (r *WorkflowJobTemplateApprovalNode) Read(ctx context.Context, req resource
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/", id)
 
 	responseBody, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
@@ -257,7 +253,7 @@ func (r *WorkflowJobTemplateApprovalNode) Read(ctx context.Context, req resource
 
 	/// now read the node's template's data
 
-	url = fmt.Sprintf("/api/v2/workflow_approval_templates/%d/", getNameFromResponse.ApprovalTemplateId)
+	url = r.client.APIPath("workflow_approval_templates/%d/", getNameFromResponse.ApprovalTemplateId)
 
 	responseBody, statusCode, err = r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
@@ -332,13 +328,11 @@ func (r *WorkflowJobTemplateApprovalNode) Update(ctx context.Context, req resour
 	bodyData.Description = data.Description.ValueString()
 	bodyData.Timeout = int(data.Timeout.ValueInt32())
 
-	url := fmt.Sprintf("/api/v2/workflow_approval_templates/%d/", data.ApprovalTemplateId.ValueInt32())
+	url := r.client.APIPath("workflow_approval_templates/%d/", data.ApprovalTemplateId.ValueInt32())
 
 	_, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPatch, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -360,7 +354,7 @@ func (r *WorkflowJobTemplateApprovalNode) Delete(ctx context.Context, req resour
 			"Unable convert id from string to int",
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/", id)
 
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{204})
 	if err != nil {
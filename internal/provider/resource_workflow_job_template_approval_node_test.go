@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitWorkflowJobTemplateApprovalNodeResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_approval_node" "test" {
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  name                     = "approve"
+  description              = "wait for approval"
+  timeout                  = 600
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_workflow_job_template_approval_node.test", "id"),
+					resource.TestCheckResourceAttrSet("awx_workflow_job_template_approval_node.test", "approval_template_id"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template_approval_node.test", "timeout", "600"),
+				),
+			},
+			{
+				ResourceName:      "awx_workflow_job_template_approval_node.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Read only refreshes timeout once it is in state.
+				ImportStateVerifyIgnore: []string{"timeout"},
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_approval_node" "test" {
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  name                     = "sign off"
+  description              = "wait for sign off"
+  timeout                  = 3600
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template_approval_node.test", "name", "sign off"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template_approval_node.test", "timeout", "3600"),
+				),
+			},
+		},
+	})
+}
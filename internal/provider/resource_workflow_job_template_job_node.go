@@ -211,12 +211,10 @@ func (r *WorkflowJobTemplatesJobNodeResource) Create(ctx context.Context, req re
 		bodyData.Identifier = data.Identifier.ValueString()
 	}
 
-	url := "/api/v2/workflow_job_template_nodes/"
+	url := r.client.APIPath("workflow_job_template_nodes/")
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPost, url, bodyData, []int{201})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API http request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API http request", err)
 		return
 	}
 
@@ -253,7 +251,7 @@ func (r *WorkflowJobTemplatesJobNodeResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/", id)
 	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -458,12 +456,10 @@ func (r *WorkflowJobTemplatesJobNodeResource) Update(ctx context.Context, req re
 	bodyData.AllParentsMustConverge = data.AllParentsMustConverge.ValueBool()
 	bodyData.Identifier = data.Identifier.ValueString()
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/", id)
 	returnedData, _, err := r.client.CreateUpdateAPIRequest(ctx, http.MethodPut, url, bodyData, []int{200})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error making API update request",
-			fmt.Sprintf("Error was: %s.", err.Error()))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, "Error making API update request", err)
 		return
 	}
 
@@ -498,7 +494,7 @@ func (r *WorkflowJobTemplatesJobNodeResource) Delete(ctx context.Context, req re
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/", id)
 	_, _, err = r.client.GenericAPIRequest(ctx, http.MethodDelete, url, nil, []int{202, 204})
 	if err != nil {
 		resp.Diagnostics.AddError(
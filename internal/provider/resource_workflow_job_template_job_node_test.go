@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitWorkflowJobTemplatesJobNodeResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_job_node" "test" {
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  unified_job_template     = awx_job_template.test.id
+  limit                    = "web"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_workflow_job_template_job_node.test", "id"),
+					resource.TestCheckResourceAttrSet("awx_workflow_job_template_job_node.test", "identifier"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template_job_node.test", "limit", "web"),
+				),
+			},
+			{
+				ResourceName:      "awx_workflow_job_template_job_node.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_job_node" "test" {
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  unified_job_template     = awx_job_template.test.id
+  limit                    = "db"
+  identifier               = "deploy"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template_job_node.test", "limit", "db"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template_job_node.test", "identifier", "deploy"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -87,7 +86,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Create(ctx context.Context, req
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/always_nodes/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/always_nodes/", id)
 
 	var relatedIds []int
 
@@ -129,9 +128,9 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Read(ctx context.Context, req r
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/always_nodes/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/always_nodes/", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	tfRelatedIds, statusCode, err := r.client.ListChildIds(ctx, url, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -144,22 +143,6 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Read(ctx context.Context, req r
 		return
 	}
 
-	var responseData JTCredentialAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	tfRelatedIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		tfRelatedIds = append(tfRelatedIds, v.Id)
-	}
-
 	listValue, diags := types.SetValueFrom(ctx, types.Int32Type, tfRelatedIds)
 	if diags.HasError() {
 		return
@@ -184,9 +167,9 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/always_nodes/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/always_nodes/", id)
 
-	body, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	ApiTfChildIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -194,22 +177,6 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 		return
 	}
 
-	var responseData JTChildAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	ApiTfChildIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		ApiTfChildIds = append(ApiTfChildIds, v.Id)
-	}
-
 	var PlanChildIds []int
 	diags := data.AlwaysNodeIds.ElementsAs(ctx, &PlanChildIds, false)
 	if diags.HasError() {
@@ -222,6 +189,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Update(ctx context.Context, req
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
 			if err != nil {
@@ -264,7 +232,7 @@ func (r *WorkflowJobTemplatesNodeAlwaysResource) Delete(ctx context.Context, req
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/always_nodes/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/always_nodes/", id)
 
 	var RelatedIds []int
 
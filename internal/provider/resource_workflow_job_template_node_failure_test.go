@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitWorkflowJobTemplatesNodeFailureResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_job_node" "test" {
+  count                    = 3
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  unified_job_template     = awx_job_template.test.id
+}
+
+resource "awx_workflow_job_template_node_failure" "test" {
+  id = awx_workflow_job_template_job_node.test[0].id
+  failure_ids = [
+    awx_workflow_job_template_job_node.test[1].id,
+    awx_workflow_job_template_job_node.test[2].id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template_node_failure.test", "failure_ids.#", "2"),
+					testCheckRelatedCount(server, "awx_workflow_job_template_node_failure.test", "id", "workflow_job_template_nodes", "failure_nodes", 2),
+				),
+			},
+			{
+				ResourceName:      "awx_workflow_job_template_node_failure.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_project" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+  scm_type     = "git"
+  scm_url      = "https://github.com/ansible/ansible-tower-samples"
+}
+
+resource "awx_job_template" "test" {
+  name     = "test"
+  project  = awx_project.test.id
+  playbook = "hello_world.yml"
+
+  ask_inventory_on_launch = true
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  organization = awx_organization.test.id
+}
+
+resource "awx_workflow_job_template_job_node" "test" {
+  count                    = 3
+  workflow_job_template_id = awx_workflow_job_template.test.id
+  unified_job_template     = awx_job_template.test.id
+}
+
+resource "awx_workflow_job_template_node_failure" "test" {
+  id = awx_workflow_job_template_job_node.test[0].id
+  failure_ids = [awx_workflow_job_template_job_node.test[2].id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template_node_failure.test", "failure_ids.#", "1"),
+					testCheckRelatedCount(server, "awx_workflow_job_template_node_failure.test", "id", "workflow_job_template_nodes", "failure_nodes", 1),
+				),
+			},
+		},
+	})
+}
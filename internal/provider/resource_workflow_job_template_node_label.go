@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -87,7 +86,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Create(ctx context.Context, req
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/labels/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/labels/", id)
 
 	var relatedIds []int
 
@@ -127,9 +126,9 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Read(ctx context.Context, req re
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 		return
 	}
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/labels", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/labels", id)
 
-	body, statusCode, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200, 404})
+	tfRelatedIds, statusCode, err := r.client.ListChildIds(ctx, url, []int{200, 404})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -142,22 +141,6 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Read(ctx context.Context, req re
 		return
 	}
 
-	var responseData JTCredentialAPIRead
-
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	tfRelatedIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		tfRelatedIds = append(tfRelatedIds, v.Id)
-	}
-
 	listValue, diags := types.SetValueFrom(ctx, types.Int32Type, tfRelatedIds)
 	if diags.HasError() {
 		return
@@ -182,9 +165,9 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 		return
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/labels/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/labels/", id)
 
-	responseBody, _, err := r.client.GenericAPIRequest(ctx, http.MethodGet, url, nil, []int{200})
+	ApiTfChildIds, _, err := r.client.ListChildIds(ctx, url, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error making API http request",
@@ -192,22 +175,6 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 		return
 	}
 
-	var responseData JTChildAPIRead
-
-	err = json.Unmarshal(responseBody, &responseData)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable unmarshal response body into object",
-			fmt.Sprintf("Error =  %v. ", err.Error()))
-		return
-	}
-
-	ApiTfChildIds := make([]int, 0, responseData.Count)
-
-	for _, v := range responseData.Results {
-		ApiTfChildIds = append(ApiTfChildIds, v.Id)
-	}
-
 	var PlanChildIds []int
 	diags := data.LabelIDs.ElementsAs(ctx, &PlanChildIds, false)
 	if diags.HasError() {
@@ -220,6 +187,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Update(ctx context.Context, req
 		if !slices.Contains(PlanChildIds, v) {
 			var bodyData ChildDissasocBody
 			bodyData.Id = v
+			bodyData.Disassociate = true
 
 			_, _, err = r.client.GenericAPIRequest(ctx, http.MethodPost, url, bodyData, []int{204})
 			if err != nil {
@@ -261,7 +229,7 @@ func (r *WorkflowJobTemplatesNodeLabelResource) Delete(ctx context.Context, req
 			fmt.Sprintf("Unable to convert id: %v. ", data.Id.ValueString()))
 	}
 
-	url := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/labels/", id)
+	url := r.client.APIPath("workflow_job_template_nodes/%d/labels/", id)
 
 	var RelatedIds []int
 
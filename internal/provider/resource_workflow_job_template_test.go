@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/TravisStratton/terraform-provider-awx/internal/awxmock"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUnitWorkflowJobTemplateResource(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_workflow_job_template" "test" {
+  name         = "test"
+  description  = "test workflow"
+  organization = awx_organization.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("awx_workflow_job_template.test", "id"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "name", "test"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "survey_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "awx_workflow_job_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testProviderConfig(server) + `
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_workflow_job_template" "test" {
+  name                 = "renamed"
+  description          = "renamed workflow"
+  organization         = awx_organization.test.id
+  limit                = "web"
+  allow_simultaneous   = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "allow_simultaneous", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWorkflowJobTemplateResourceWebhook(t *testing.T) {
+	server := awxmock.NewServer()
+	defer server.Close()
+
+	config := func(description, webhookService, rotate string) string {
+		return testProviderConfig(server) + fmt.Sprintf(`
+resource "awx_organization" "test" {
+  name = "test"
+}
+
+resource "awx_workflow_job_template" "test" {
+  name               = "test"
+  description        = %q
+  organization       = awx_organization.test.id
+  webhook_service    = %q
+  rotate_webhook_key = %q
+}
+`, description, webhookService, rotate)
+	}
+
+	var key string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("test", "gitlab", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("awx_workflow_job_template.test", "webhook_url", regexp.MustCompile(`^http://.+/api/v2/workflow_job_templates/\d+/gitlab/$`)),
+					testCheckWebhookKey(server, "awx_workflow_job_template.test", "workflow_job_templates", &key, true),
+				),
+			},
+			{
+				ResourceName:            "awx_workflow_job_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_webhook_key"},
+			},
+			{
+				// an unrelated change keeps the key
+				Config: config("renamed", "gitlab", "1"),
+				Check:  testCheckWebhookKey(server, "awx_workflow_job_template.test", "workflow_job_templates", &key, false),
+			},
+			{
+				Config: config("renamed", "gitlab", "2"),
+				Check:  testCheckWebhookKey(server, "awx_workflow_job_template.test", "workflow_job_templates", &key, true),
+			},
+			{
+				Config: config("renamed", "", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "webhook_key", ""),
+					resource.TestCheckResourceAttr("awx_workflow_job_template.test", "webhook_url", ""),
+				),
+			},
+		},
+	})
+}
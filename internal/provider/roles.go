@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// roleResourceTypes maps the resource types roles can be looked up on to their API collection.
+var roleResourceTypes = map[string]string{
+	"credential":            "credentials",
+	"instance_group":        "instance_groups",
+	"inventory":             "inventories",
+	"job_template":          "job_templates",
+	"organization":          "organizations",
+	"project":               "projects",
+	"team":                  "teams",
+	"workflow_job_template": "workflow_job_templates",
+}
+
+// roleResourceTypeNames returns the keys of roleResourceTypes in a stable order, for validators and docs.
+func roleResourceTypeNames() []string {
+	return sortedKeys(roleResourceTypes)
+}
+
+// lookupObjectRole resolves a role field such as execute_role on an object to the role,
+// from the object's summary_fields.object_roles. A statusCode of 404 means the object does
+// not exist, while a role the object does not have is returned as an error.
+func (c *AwxClient) lookupObjectRole(ctx context.Context, resourceType string, resourceId int, roleField string) (role ObjectRoleAPIModel, statusCode int, err error) {
+	collection, ok := roleResourceTypes[resourceType]
+	if !ok {
+		err = fmt.Errorf("roles cannot be looked up on resource type %q", resourceType)
+		return
+	}
+
+	body, statusCode, err := c.GenericAPIRequest(ctx, http.MethodGet, c.APIPath("%s/%d/", collection, resourceId), nil, []int{200, 404})
+	if err != nil || statusCode == 404 {
+		return
+	}
+
+	var object struct {
+		SummaryFields struct {
+			ObjectRoles map[string]ObjectRoleAPIModel `json:"object_roles"`
+		} `json:"summary_fields"`
+	}
+	if err = json.Unmarshal(body, &object); err != nil {
+		err = fmt.Errorf("unable to unmarshal %s %d: %v", resourceType, resourceId, err)
+		return
+	}
+
+	role, ok = object.SummaryFields.ObjectRoles[roleField]
+	if !ok {
+		err = fmt.Errorf("%s %d has no role %q, its roles are: %s", resourceType, resourceId, roleField,
+			strings.Join(sortedKeys(object.SummaryFields.ObjectRoles), ", "))
+	}
+	return
+}
+
+// roleDefinitionNames returns the names AWX 24 and later give the role definition of a role
+// field on an object of resourceType: the managed one, e.g. "JobTemplate Execute", and the one
+// created for backwards compatibility, e.g. "JobTemplate Execute Compat".
+func roleDefinitionNames(resourceType, roleField string) []string {
+	model := ""
+	for _, word := range strings.Split(resourceType, "_") {
+		model += strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	actionWords := strings.Split(strings.TrimSuffix(roleField, "_role"), "_")
+	for i, word := range actionWords {
+		if word != "" {
+			actionWords[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("%s %s", model, strings.Join(actionWords, " ")),
+		fmt.Sprintf("%s %s Compat", model, strings.Join(actionWords, "_")),
+	}
+}
+
+// lookupRoleDefinition resolves a role field such as execute_role on an object of resourceType
+// to the role definition granting it through the role based access control API of AWX 24 and later.
+func (c *AwxClient) lookupRoleDefinition(ctx context.Context, resourceType, roleField string) (id int, err error) {
+	names := roleDefinitionNames(resourceType, roleField)
+	for _, name := range names {
+		var ids []int
+		ids, _, err = c.ListChildIds(ctx, c.APIPath("role_definitions/?name=%s", url.QueryEscape(name)), []int{200})
+		if err != nil {
+			return
+		}
+		if len(ids) > 0 {
+			return ids[0], nil
+		}
+	}
+
+	err = fmt.Errorf("no role definition grants %s on %s objects, expected one named %q or %q", roleField, resourceType, names[0], names[1])
+	return
+}
@@ -4,11 +4,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-type JTChildAPIRead struct {
-	Count   int           `json:"count"`
-	Results []ChildResult `json:"results"`
-}
-
 type ChildResult struct {
 	Id int `json:"id"`
 }
@@ -23,11 +18,6 @@ type ChildDissasocBody struct {
 	Disassociate bool `json:"disassociate"`
 }
 
-type JTLabelsAPIRead struct {
-	Count        int           `json:"count"`
-	LabelResults []LabelResult `json:"results"`
-}
-
 type LabelResult struct {
 	Id int `json:"id"`
 }
@@ -37,6 +27,26 @@ type LabelDissasocBody struct {
 	Disassociate bool `json:"disassociate"`
 }
 
+type ConstructedInventoryModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Organization     types.Int32  `tfsdk:"organization"`
+	Variables        yamlValue    `tfsdk:"variables"`
+	InputInventories types.List   `tfsdk:"input_inventories"`
+	SourceVars       yamlValue    `tfsdk:"source_vars"`
+	Limit            types.String `tfsdk:"limit"`
+}
+type ConstructedInventoryAPIModel struct {
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Organization int    `json:"organization"`
+	Variables    string `json:"variables"`
+	SourceVars   string `json:"source_vars"`
+	Limit        string `json:"limit"`
+}
+
 type CredentialModel struct {
 	Id             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
@@ -61,6 +71,23 @@ type CredentialAPIModel struct {
 	Inputs         any    `json:"inputs"`
 }
 
+type CredentialInputSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	TargetCredential types.Int32  `tfsdk:"target_credential"`
+	InputFieldName   types.String `tfsdk:"input_field_name"`
+	SourceCredential types.Int32  `tfsdk:"source_credential"`
+	Metadata         jsonValue    `tfsdk:"metadata"`
+}
+type CredentialInputSourceAPIModel struct {
+	Id               int            `json:"id"`
+	Description      string         `json:"description"`
+	TargetCredential int            `json:"target_credential"`
+	InputFieldName   string         `json:"input_field_name"`
+	SourceCredential int            `json:"source_credential"`
+	Metadata         map[string]any `json:"metadata"`
+}
+
 type CredentialTypeModel struct {
 	Id          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -79,6 +106,41 @@ type CredentialTypeAPIModel struct {
 	Kind        string `json:"kind"`
 }
 
+type ExecutionEnvironmentModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Image        types.String `tfsdk:"image"`
+	Pull         types.String `tfsdk:"pull"`
+	Organization types.Int32  `tfsdk:"organization"`
+	Credential   types.Int32  `tfsdk:"credential"`
+}
+
+type ExecutionEnvironmentAPIModel struct {
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Image        string `json:"image"`
+	Pull         string `json:"pull"`
+	Organization *int   `json:"organization"` // null for a global execution environment
+	Credential   *int   `json:"credential"`
+}
+
+type GroupModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Inventory   types.Int32  `tfsdk:"inventory"`
+	Variables   yamlValue    `tfsdk:"variables"`
+}
+type GroupAPIModel struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Inventory   int    `json:"inventory"`
+	Variables   string `json:"variables"`
+}
+
 type HostModel struct {
 	Id          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -97,6 +159,32 @@ type HostAPIModel struct {
 	Variables   string `json:"variables,omitempty"`
 }
 
+type InstanceGroupModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	IsContainerGroup         types.Bool   `tfsdk:"is_container_group"`
+	Credential               types.Int32  `tfsdk:"credential"`
+	PodSpecOverride          yamlValue    `tfsdk:"pod_spec_override"`
+	PolicyInstancePercentage types.Int32  `tfsdk:"policy_instance_percentage"`
+	PolicyInstanceMinimum    types.Int32  `tfsdk:"policy_instance_minimum"`
+	PolicyInstanceList       types.Set    `tfsdk:"policy_instance_list"`
+	MaxConcurrentJobs        types.Int32  `tfsdk:"max_concurrent_jobs"`
+	MaxForks                 types.Int32  `tfsdk:"max_forks"`
+}
+
+type InstanceGroupAPIModel struct {
+	Id                       int      `json:"id"`
+	Name                     string   `json:"name"`
+	IsContainerGroup         bool     `json:"is_container_group"`
+	Credential               *int     `json:"credential"`
+	PodSpecOverride          string   `json:"pod_spec_override"`
+	PolicyInstancePercentage int      `json:"policy_instance_percentage"`
+	PolicyInstanceMinimum    int      `json:"policy_instance_minimum"`
+	PolicyInstanceList       []string `json:"policy_instance_list"`
+	MaxConcurrentJobs        int      `json:"max_concurrent_jobs"`
+	MaxForks                 int      `json:"max_forks"`
+}
+
 type InventoryModel struct {
 	Id           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
@@ -209,6 +297,15 @@ type JobTemplateModel struct {
 	PreventInstanceGroupFallback   types.Bool   `tfsdk:"prevent_instance_group_fallback"`
 }
 
+// JobTemplateResourceModel is the model of the job template resource, which unlike the
+// data source also manages the template's webhook key.
+type JobTemplateResourceModel struct {
+	JobTemplateModel
+	WebhookKey       types.String `tfsdk:"webhook_key"`
+	WebhookURL       types.String `tfsdk:"webhook_url"`
+	RotateWebhookKey types.String `tfsdk:"rotate_webhook_key"`
+}
+
 type JobTemplateAPIModel struct {
 	Id                             int    `json:"id"`
 	Name                           string `json:"name,omitempty"`
@@ -252,7 +349,7 @@ type JobTemplateAPIModel struct {
 	AllowSimultaneous              bool   `json:"allow_simultaneous,omitempty"`
 	CustomVirtualEnv               any    `json:"custom_virtualenv,omitempty"` //blank is returned by api as "custom_virtual": null (not "")
 	JobSliceCount                  int    `json:"job_slice_count,omitempty"`
-	WebhookService                 string `json:"webhook_service,omitempty"`
+	WebhookService                 string `json:"webhook_service"`
 	WebhookCredential              any    `json:"webhook_credential,omitempty"` //blank is returned by api as "webhook_credentials": null (not "")
 	PreventInstanceGroupFallback   bool   `json:"prevent_instance_group_fallback,omitempty"`
 }
@@ -269,6 +366,16 @@ type LabelAPIModel struct {
 	Organization int    `json:"organization"`
 }
 
+// NotificationAttachmentModel maps the notification templates a parent sends on an event.
+type NotificationAttachmentModel struct {
+	Id                      types.String `tfsdk:"id"`
+	ParentType              types.String `tfsdk:"parent_type"`
+	ParentId                types.Int32  `tfsdk:"parent_id"`
+	Event                   types.String `tfsdk:"event"`
+	NotificationTemplateIds types.Set    `tfsdk:"notification_template_ids"`
+	Authoritative           types.Bool   `tfsdk:"authoritative"`
+}
+
 type OrganizationModel struct {
 	Id               types.String `tfsdk:"id"`
 	Name             types.String `tfsdk:"name"`
@@ -325,6 +432,48 @@ type ProjectAPIModel struct {
 	ScmUrl             string `json:"scm_url,omitempty"`
 }
 
+type RoleModel struct {
+	Id           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceId   types.Int32  `tfsdk:"resource_id"`
+	Role         types.String `tfsdk:"role"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+}
+
+type RoleAssignmentModel struct {
+	Id           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceId   types.Int32  `tfsdk:"resource_id"`
+	Role         types.String `tfsdk:"role"`
+	RoleId       types.Int32  `tfsdk:"role_id"`
+	UserId       types.Int32  `tfsdk:"user_id"`
+	TeamId       types.Int32  `tfsdk:"team_id"`
+}
+
+// ObjectRoleAPIModel is a role listed in an object's summary_fields.object_roles, keyed by its field name, e.g. execute_role.
+type ObjectRoleAPIModel struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RoleDefinitionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	ContentType types.String `tfsdk:"content_type"`
+}
+
+type RoleDefinitionAPIModel struct {
+	Id          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+	ContentType *string  `json:"content_type"`
+}
+
 type ScheduleModel struct {
 	Id                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
@@ -343,6 +492,20 @@ type ScheduleAPIModel struct {
 	Enabled            bool   `json:"enabled"`
 }
 
+type TeamModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Organization types.Int32  `tfsdk:"organization"`
+}
+
+type TeamAPIModel struct {
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Organization int    `json:"organization"`
+}
+
 type UserModel struct {
 	Id              types.String `tfsdk:"id"`
 	Username        types.String `tfsdk:"username"`
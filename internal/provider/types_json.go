@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = jsonType{}
+var _ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+var _ xattr.ValidateableAttribute = jsonValue{}
+
+// jsonType is a string attribute holding a JSON document. Documents that decode to the same
+// value are semantically equal, so whitespace, key order or the controller re-serializing the
+// document does not show up as a difference.
+type jsonType struct {
+	basetypes.StringType
+}
+
+func (t jsonType) String() string {
+	return "jsonType"
+}
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) ValueType(ctx context.Context) attr.Value {
+	return jsonValue{}
+}
+
+func (t jsonType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonValue{StringValue: in}, nil
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return jsonValue{StringValue: stringValue}, nil
+}
+
+// jsonValue is the value of a jsonType attribute.
+type jsonValue struct {
+	basetypes.StringValue
+}
+
+func newJSONValue(value string) jsonValue {
+	return jsonValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonValue) Type(ctx context.Context) attr.Type {
+	return jsonType{}
+}
+
+func (v jsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected a jsonValue, got: %T. Please report this issue to the provider developers.", newValuable))
+		return false, diags
+	}
+
+	var oldDocument, newDocument any
+	if err := json.Unmarshal([]byte(v.ValueString()), &oldDocument); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &newDocument); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument), diags
+}
+
+func (v jsonValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"The value is not a valid JSON document.")
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var _ basetypes.StringTypable = yamlType{}
+var _ basetypes.StringValuableWithSemanticEquals = yamlValue{}
+var _ xattr.ValidateableAttribute = yamlValue{}
+
+// yamlType is a string attribute holding a YAML document. Documents that decode to the same
+// value are semantically equal, so reformatting, reordering keys or the controller normalizing
+// the document does not show up as a difference.
+type yamlType struct {
+	basetypes.StringType
+}
+
+func (t yamlType) String() string {
+	return "yamlType"
+}
+
+func (t yamlType) Equal(o attr.Type) bool {
+	other, ok := o.(yamlType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t yamlType) ValueType(ctx context.Context) attr.Value {
+	return yamlValue{}
+}
+
+func (t yamlType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return yamlValue{StringValue: in}, nil
+}
+
+func (t yamlType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return yamlValue{StringValue: stringValue}, nil
+}
+
+// yamlValue is the value of a yamlType attribute.
+type yamlValue struct {
+	basetypes.StringValue
+}
+
+func newYAMLValue(value string) yamlValue {
+	return yamlValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func newYAMLNull() yamlValue {
+	return yamlValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v yamlValue) Type(ctx context.Context) attr.Type {
+	return yamlType{}
+}
+
+func (v yamlValue) Equal(o attr.Value) bool {
+	other, ok := o.(yamlValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v yamlValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(yamlValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected a yamlValue, got: %T. Please report this issue to the provider developers.", newValuable))
+		return false, diags
+	}
+
+	var oldDocument, newDocument any
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &oldDocument); err != nil {
+		return false, diags
+	}
+	if err := yaml.Unmarshal([]byte(newValue.ValueString()), &newDocument); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument), diags
+}
+
+func (v yamlValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	var document any
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &document); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML",
+			fmt.Sprintf("The value is not a valid YAML document: %s.", err.Error()))
+	}
+}
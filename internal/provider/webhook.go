@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookKeyAPIModel is the body of the webhook_key/ endpoint of a job or workflow job template.
+type webhookKeyAPIModel struct {
+	WebhookKey string `json:"webhook_key"`
+}
+
+// webhookAttributes are the attributes of the webhook key and receiver of job and workflow job templates.
+func webhookAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"webhook_key": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The key the webhook service signs its requests with, generated by AWX when `webhook_service` is set. Reading it needs admin rights on the template, it is `\"\"` without them.",
+		},
+		"webhook_url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL the webhook service posts to, `\"\"` when `webhook_service` is not set.",
+		},
+		"rotate_webhook_key": schema.StringAttribute{
+			Optional:    true,
+			Description: "Any value, e.g. a date. Changing it regenerates `webhook_key`.",
+		},
+	}
+}
+
+// readWebhook returns the webhook key of the template at collection/id/ and the URL of its
+// webhook receiver, listed in the template's related links. When rotate is set the key is
+// regenerated first. A template without a webhook_service has neither, and its key is not
+// requested at all: reading it needs admin rights on the template. For the same reason a
+// key that cannot be read for lack of them is a warning, and is returned empty.
+func (c *AwxClient) readWebhook(ctx context.Context, collection string, id int, template map[string]any, rotate bool, diags *diag.Diagnostics) (key, url string) {
+	if service, _ := template["webhook_service"].(string); service == "" {
+		return
+	}
+
+	related, _ := template["related"].(map[string]any)
+	if receiver, _ := related["webhook_receiver"].(string); receiver != "" {
+		url = c.endpoint + receiver
+	}
+
+	keyURL := c.APIPath("%s/%d/webhook_key/", collection, id)
+
+	var body []byte
+	var statusCode int
+	var err error
+	if rotate {
+		body, _, err = c.GenericAPIRequest(ctx, http.MethodPost, keyURL, map[string]any{}, []int{201})
+	} else {
+		body, statusCode, err = c.GenericAPIRequest(ctx, http.MethodGet, keyURL, nil, []int{200, 403})
+	}
+	if err != nil {
+		diags.AddError(
+			"Error reading webhook key",
+			fmt.Sprintf("Error was: %s.", err.Error()))
+		return
+	}
+
+	if statusCode == http.StatusForbidden {
+		diags.AddWarning(
+			"Unable to read webhook key",
+			fmt.Sprintf("Reading the webhook key of %s %d needs admin rights on it, webhook_key is left empty.", collection, id))
+		return
+	}
+
+	var webhookKey webhookKeyAPIModel
+	if err = json.Unmarshal(body, &webhookKey); err != nil {
+		diags.AddError(
+			"Error reading webhook key",
+			fmt.Sprintf("Unable to unmarshal webhook key: %v.", err))
+		return
+	}
+	key = webhookKey.WebhookKey
+	return
+}
+
+// planWebhook keeps the webhook key and URL of the state in the plan, unless a change to
+// webhook_service or rotate_webhook_key is going to change them.
+func planWebhook(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planService, stateService, planRotate, stateRotate, key, url types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("webhook_service"), &planService)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("webhook_service"), &stateService)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_webhook_key"), &planRotate)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_webhook_key"), &stateRotate)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("webhook_key"), &key)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("webhook_url"), &url)...)
+	if resp.Diagnostics.HasError() || !planService.Equal(stateService) {
+		return
+	}
+
+	// a state written before these attributes existed has neither until it is refreshed
+	if !url.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("webhook_url"), url)...)
+	}
+	if !key.IsNull() && planRotate.Equal(stateRotate) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("webhook_key"), key)...)
+	}
+}
@@ -32,6 +32,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// revoke any tokens exchanged for a username and password during this run
+	if revokeErr := provider.RevokeTokens(context.Background()); revokeErr != nil {
+		log.Print(revokeErr.Error())
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}